@@ -27,7 +27,9 @@ type VectorStoreServiceClient interface {
 	CreateVectorStoreFile(ctx context.Context, in *CreateVectorStoreFileRequest, opts ...grpc.CallOption) (*VectorStoreFile, error)
 	ListVectorStoreFiles(ctx context.Context, in *ListVectorStoreFilesRequest, opts ...grpc.CallOption) (*ListVectorStoreFilesResponse, error)
 	GetVectorStoreFile(ctx context.Context, in *GetVectorStoreFileRequest, opts ...grpc.CallOption) (*VectorStoreFile, error)
+	UpdateVectorStoreFile(ctx context.Context, in *UpdateVectorStoreFileRequest, opts ...grpc.CallOption) (*VectorStoreFile, error)
 	DeleteVectorStoreFile(ctx context.Context, in *DeleteVectorStoreFileRequest, opts ...grpc.CallOption) (*DeleteVectorStoreFileResponse, error)
+	SearchVectorStore(ctx context.Context, in *VectorStoreSearchRequest, opts ...grpc.CallOption) (*VectorStoreSearchResponse, error)
 }
 
 type vectorStoreServiceClient struct {
@@ -119,6 +121,15 @@ func (c *vectorStoreServiceClient) GetVectorStoreFile(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *vectorStoreServiceClient) UpdateVectorStoreFile(ctx context.Context, in *UpdateVectorStoreFileRequest, opts ...grpc.CallOption) (*VectorStoreFile, error) {
+	out := new(VectorStoreFile)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/UpdateVectorStoreFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vectorStoreServiceClient) DeleteVectorStoreFile(ctx context.Context, in *DeleteVectorStoreFileRequest, opts ...grpc.CallOption) (*DeleteVectorStoreFileResponse, error) {
 	out := new(DeleteVectorStoreFileResponse)
 	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/DeleteVectorStoreFile", in, out, opts...)
@@ -128,6 +139,15 @@ func (c *vectorStoreServiceClient) DeleteVectorStoreFile(ctx context.Context, in
 	return out, nil
 }
 
+func (c *vectorStoreServiceClient) SearchVectorStore(ctx context.Context, in *VectorStoreSearchRequest, opts ...grpc.CallOption) (*VectorStoreSearchResponse, error) {
+	out := new(VectorStoreSearchResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreService/SearchVectorStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VectorStoreServiceServer is the server API for VectorStoreService service.
 // All implementations must embed UnimplementedVectorStoreServiceServer
 // for forward compatibility
@@ -141,7 +161,9 @@ type VectorStoreServiceServer interface {
 	CreateVectorStoreFile(context.Context, *CreateVectorStoreFileRequest) (*VectorStoreFile, error)
 	ListVectorStoreFiles(context.Context, *ListVectorStoreFilesRequest) (*ListVectorStoreFilesResponse, error)
 	GetVectorStoreFile(context.Context, *GetVectorStoreFileRequest) (*VectorStoreFile, error)
+	UpdateVectorStoreFile(context.Context, *UpdateVectorStoreFileRequest) (*VectorStoreFile, error)
 	DeleteVectorStoreFile(context.Context, *DeleteVectorStoreFileRequest) (*DeleteVectorStoreFileResponse, error)
+	SearchVectorStore(context.Context, *VectorStoreSearchRequest) (*VectorStoreSearchResponse, error)
 	mustEmbedUnimplementedVectorStoreServiceServer()
 }
 
@@ -176,9 +198,15 @@ func (UnimplementedVectorStoreServiceServer) ListVectorStoreFiles(context.Contex
 func (UnimplementedVectorStoreServiceServer) GetVectorStoreFile(context.Context, *GetVectorStoreFileRequest) (*VectorStoreFile, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVectorStoreFile not implemented")
 }
+func (UnimplementedVectorStoreServiceServer) UpdateVectorStoreFile(context.Context, *UpdateVectorStoreFileRequest) (*VectorStoreFile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVectorStoreFile not implemented")
+}
 func (UnimplementedVectorStoreServiceServer) DeleteVectorStoreFile(context.Context, *DeleteVectorStoreFileRequest) (*DeleteVectorStoreFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVectorStoreFile not implemented")
 }
+func (UnimplementedVectorStoreServiceServer) SearchVectorStore(context.Context, *VectorStoreSearchRequest) (*VectorStoreSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchVectorStore not implemented")
+}
 func (UnimplementedVectorStoreServiceServer) mustEmbedUnimplementedVectorStoreServiceServer() {}
 
 // UnsafeVectorStoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -354,6 +382,24 @@ func _VectorStoreService_GetVectorStoreFile_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VectorStoreService_UpdateVectorStoreFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVectorStoreFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreServiceServer).UpdateVectorStoreFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreService/UpdateVectorStoreFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreServiceServer).UpdateVectorStoreFile(ctx, req.(*UpdateVectorStoreFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VectorStoreService_DeleteVectorStoreFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteVectorStoreFileRequest)
 	if err := dec(in); err != nil {
@@ -372,6 +418,24 @@ func _VectorStoreService_DeleteVectorStoreFile_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VectorStoreService_SearchVectorStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorStoreSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreServiceServer).SearchVectorStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreService/SearchVectorStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreServiceServer).SearchVectorStore(ctx, req.(*VectorStoreSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VectorStoreService_ServiceDesc is the grpc.ServiceDesc for VectorStoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -415,10 +479,18 @@ var VectorStoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVectorStoreFile",
 			Handler:    _VectorStoreService_GetVectorStoreFile_Handler,
 		},
+		{
+			MethodName: "UpdateVectorStoreFile",
+			Handler:    _VectorStoreService_UpdateVectorStoreFile_Handler,
+		},
 		{
 			MethodName: "DeleteVectorStoreFile",
 			Handler:    _VectorStoreService_DeleteVectorStoreFile_Handler,
 		},
+		{
+			MethodName: "SearchVectorStore",
+			Handler:    _VectorStoreService_SearchVectorStore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/vector_store.proto",
@@ -429,6 +501,12 @@ var VectorStoreService_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type VectorStoreInternalServiceClient interface {
 	SearchVectorStore(ctx context.Context, in *SearchVectorStoreRequest, opts ...grpc.CallOption) (*SearchVectorStoreResponse, error)
+	// RebuildVectorStoreIndex replaces the index of the vectors of a vector store with a new one.
+	// Milvus drops the index and builds the new one, and restores the old index if the new one
+	// cannot be built. Searches of the vector store fail until the index is built. pgvector
+	// builds the new index concurrently and replaces the old one once it is ready, so searches
+	// and writes continue to use the old index in the meantime.
+	RebuildVectorStoreIndex(ctx context.Context, in *RebuildVectorStoreIndexRequest, opts ...grpc.CallOption) (*RebuildVectorStoreIndexResponse, error)
 }
 
 type vectorStoreInternalServiceClient struct {
@@ -448,11 +526,26 @@ func (c *vectorStoreInternalServiceClient) SearchVectorStore(ctx context.Context
 	return out, nil
 }
 
+func (c *vectorStoreInternalServiceClient) RebuildVectorStoreIndex(ctx context.Context, in *RebuildVectorStoreIndexRequest, opts ...grpc.CallOption) (*RebuildVectorStoreIndexResponse, error) {
+	out := new(RebuildVectorStoreIndexResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.vector_store.v1.VectorStoreInternalService/RebuildVectorStoreIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VectorStoreInternalServiceServer is the server API for VectorStoreInternalService service.
 // All implementations must embed UnimplementedVectorStoreInternalServiceServer
 // for forward compatibility
 type VectorStoreInternalServiceServer interface {
 	SearchVectorStore(context.Context, *SearchVectorStoreRequest) (*SearchVectorStoreResponse, error)
+	// RebuildVectorStoreIndex replaces the index of the vectors of a vector store with a new one.
+	// Milvus drops the index and builds the new one, and restores the old index if the new one
+	// cannot be built. Searches of the vector store fail until the index is built. pgvector
+	// builds the new index concurrently and replaces the old one once it is ready, so searches
+	// and writes continue to use the old index in the meantime.
+	RebuildVectorStoreIndex(context.Context, *RebuildVectorStoreIndexRequest) (*RebuildVectorStoreIndexResponse, error)
 	mustEmbedUnimplementedVectorStoreInternalServiceServer()
 }
 
@@ -463,6 +556,9 @@ type UnimplementedVectorStoreInternalServiceServer struct {
 func (UnimplementedVectorStoreInternalServiceServer) SearchVectorStore(context.Context, *SearchVectorStoreRequest) (*SearchVectorStoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchVectorStore not implemented")
 }
+func (UnimplementedVectorStoreInternalServiceServer) RebuildVectorStoreIndex(context.Context, *RebuildVectorStoreIndexRequest) (*RebuildVectorStoreIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildVectorStoreIndex not implemented")
+}
 func (UnimplementedVectorStoreInternalServiceServer) mustEmbedUnimplementedVectorStoreInternalServiceServer() {
 }
 
@@ -495,6 +591,24 @@ func _VectorStoreInternalService_SearchVectorStore_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VectorStoreInternalService_RebuildVectorStoreIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildVectorStoreIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VectorStoreInternalServiceServer).RebuildVectorStoreIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.vector_store.v1.VectorStoreInternalService/RebuildVectorStoreIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VectorStoreInternalServiceServer).RebuildVectorStoreIndex(ctx, req.(*RebuildVectorStoreIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VectorStoreInternalService_ServiceDesc is the grpc.ServiceDesc for VectorStoreInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -506,6 +620,10 @@ var VectorStoreInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchVectorStore",
 			Handler:    _VectorStoreInternalService_SearchVectorStore_Handler,
 		},
+		{
+			MethodName: "RebuildVectorStoreIndex",
+			Handler:    _VectorStoreInternalService_RebuildVectorStoreIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/vector_store.proto",
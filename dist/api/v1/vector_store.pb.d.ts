@@ -1,8 +1,19 @@
 import * as fm from "../../fetch.pb";
+import * as GoogleProtobufStruct from "../../google/protobuf/struct.pb";
 export type ExpiresAfter = {
     anchor?: string;
     days?: string;
 };
+export type VectorIndex = {
+    type?: string;
+    nlist?: number;
+    nprobe?: number;
+    m?: number;
+    nbits?: number;
+    ef_construction?: number;
+    ef?: number;
+    search_list?: number;
+};
 export type VectorStoreFileCounts = {
     in_progress?: string;
     completed?: string;
@@ -24,14 +35,28 @@ export type VectorStore = {
     metadata?: {
         [key: string]: string;
     };
+    rerank?: boolean;
+    metric_type?: string;
+    index?: VectorIndex;
 };
 export type ChunkingStrategyStatic = {
     max_chunk_size_tokens?: string;
     chunk_overlap_tokens?: string;
 };
+export type ChunkingStrategySemantic = {
+    breakpoint_percentile?: number;
+    max_chunk_size_tokens?: string;
+};
+export type ChunkingStrategyParentChild = {
+    max_parent_chunk_size_tokens?: string;
+    max_child_chunk_size_tokens?: string;
+};
 export type ChunkingStrategy = {
     type?: string;
     static?: ChunkingStrategyStatic;
+    splitter?: string;
+    semantic?: ChunkingStrategySemantic;
+    parent_child?: ChunkingStrategyParentChild;
 };
 export type CreateVectorStoreRequest = {
     file_ids?: string[];
@@ -41,6 +66,9 @@ export type CreateVectorStoreRequest = {
     metadata?: {
         [key: string]: string;
     };
+    rerank?: boolean;
+    metric_type?: string;
+    index?: VectorIndex;
 };
 export type ListVectorStoresRequest = {
     limit?: number;
@@ -90,11 +118,17 @@ export type VectorStoreFile = {
     status?: string;
     last_error?: VectorStoreFileError;
     chunking_strategy?: ChunkingStrategy;
+    attributes?: {
+        [key: string]: GoogleProtobufStruct.Value;
+    };
 };
 export type CreateVectorStoreFileRequest = {
     vector_store_id?: string;
     file_id?: string;
     chunking_strategy?: ChunkingStrategy;
+    attributes?: {
+        [key: string]: GoogleProtobufStruct.Value;
+    };
 };
 export type ListVectorStoreFilesRequest = {
     vector_store_id?: string;
@@ -115,6 +149,13 @@ export type GetVectorStoreFileRequest = {
     vector_store_id?: string;
     file_id?: string;
 };
+export type UpdateVectorStoreFileRequest = {
+    vector_store_id?: string;
+    file_id?: string;
+    attributes?: {
+        [key: string]: GoogleProtobufStruct.Value;
+    };
+};
 export type DeleteVectorStoreFileRequest = {
     vector_store_id?: string;
     file_id?: string;
@@ -124,13 +165,77 @@ export type DeleteVectorStoreFileResponse = {
     object?: string;
     deleted?: boolean;
 };
+export type VectorStoreSearchFilter = {
+    type?: string;
+    key?: string;
+    value?: GoogleProtobufStruct.Value;
+    filters?: VectorStoreSearchFilter[];
+};
+export type VectorStoreSearchRankingOptions = {
+    ranker?: string;
+    score_threshold?: number;
+};
+export type VectorStoreSearchRequest = {
+    vector_store_id?: string;
+    query?: string;
+    max_num_results?: number;
+    filters?: VectorStoreSearchFilter;
+    ranking_options?: VectorStoreSearchRankingOptions;
+};
+export type VectorStoreSearchResultContent = {
+    type?: string;
+    text?: string;
+};
+export type VectorStoreSearchResult = {
+    file_id?: string;
+    filename?: string;
+    score?: number;
+    attributes?: {
+        [key: string]: GoogleProtobufStruct.Value;
+    };
+    content?: VectorStoreSearchResultContent[];
+};
+export type VectorStoreSearchResponse = {
+    object?: string;
+    search_query?: string[];
+    data?: VectorStoreSearchResult[];
+    has_more?: boolean;
+    next_page?: string;
+};
 export type SearchVectorStoreRequest = {
     vector_store_id?: string;
     query?: string;
     num_documents?: number;
+    context_window?: number;
+    search_mode?: string;
+    fusion?: string;
+    vector_weight?: number;
+    rerank?: boolean;
+    mmr?: boolean;
+    mmr_lambda?: number;
+    max_results_per_file?: number;
+    score_threshold?: number;
+};
+export type SearchVectorStoreResponseResult = {
+    chunk_id?: string;
+    file_id?: string;
+    filename?: string;
+    distance?: number;
+    chunk_index?: number;
+    page_number?: number;
+    text?: string;
+    score?: number;
 };
 export type SearchVectorStoreResponse = {
     documents?: string[];
+    results?: SearchVectorStoreResponseResult[];
+};
+export type RebuildVectorStoreIndexRequest = {
+    vector_store_id?: string;
+    index?: VectorIndex;
+};
+export type RebuildVectorStoreIndexResponse = {
+    index?: VectorIndex;
 };
 export declare class VectorStoreService {
     static CreateVectorStore(req: CreateVectorStoreRequest, initReq?: fm.InitReq): Promise<VectorStore>;
@@ -142,8 +247,11 @@ export declare class VectorStoreService {
     static CreateVectorStoreFile(req: CreateVectorStoreFileRequest, initReq?: fm.InitReq): Promise<VectorStoreFile>;
     static ListVectorStoreFiles(req: ListVectorStoreFilesRequest, initReq?: fm.InitReq): Promise<ListVectorStoreFilesResponse>;
     static GetVectorStoreFile(req: GetVectorStoreFileRequest, initReq?: fm.InitReq): Promise<VectorStoreFile>;
+    static UpdateVectorStoreFile(req: UpdateVectorStoreFileRequest, initReq?: fm.InitReq): Promise<VectorStoreFile>;
     static DeleteVectorStoreFile(req: DeleteVectorStoreFileRequest, initReq?: fm.InitReq): Promise<DeleteVectorStoreFileResponse>;
+    static SearchVectorStore(req: VectorStoreSearchRequest, initReq?: fm.InitReq): Promise<VectorStoreSearchResponse>;
 }
 export declare class VectorStoreInternalService {
     static SearchVectorStore(req: SearchVectorStoreRequest, initReq?: fm.InitReq): Promise<SearchVectorStoreResponse>;
+    static RebuildVectorStoreIndex(req: RebuildVectorStoreIndexRequest, initReq?: fm.InitReq): Promise<RebuildVectorStoreIndexResponse>;
 }
@@ -32,12 +32,21 @@ export class VectorStoreService {
     static GetVectorStoreFile(req, initReq) {
         return fm.fetchReq(`/v1/vector_stores/${req["vector_store_id"]}/files/${req["file_id"]}?${fm.renderURLSearchParams(req, ["vector_store_id", "file_id"])}`, Object.assign(Object.assign({}, initReq), { method: "GET" }));
     }
+    static UpdateVectorStoreFile(req, initReq) {
+        return fm.fetchReq(`/v1/vector_stores/${req["vector_store_id"]}/files/${req["file_id"]}`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
     static DeleteVectorStoreFile(req, initReq) {
         return fm.fetchReq(`/v1/vector_stores/${req["vector_store_id"]}/files/${req["file_id"]}`, Object.assign(Object.assign({}, initReq), { method: "DELETE" }));
     }
+    static SearchVectorStore(req, initReq) {
+        return fm.fetchReq(`/v1/vector_stores/${req["vector_store_id"]}/search`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
 }
 export class VectorStoreInternalService {
     static SearchVectorStore(req, initReq) {
         return fm.fetchReq(`/llmariner.vector_store.v1.VectorStoreInternalService/SearchVectorStore`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
     }
+    static RebuildVectorStoreIndex(req, initReq) {
+        return fm.fetchReq(`/llmariner.vector_store.v1.VectorStoreInternalService/RebuildVectorStoreIndex`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
 }
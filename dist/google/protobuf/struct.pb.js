@@ -0,0 +1,9 @@
+/* eslint-disable */
+// @ts-nocheck
+/*
+* This file is a generated Typescript file for GRPC Gateway, DO NOT MODIFY
+*/
+export var NullValue;
+(function (NullValue) {
+    NullValue["NULL_VALUE"] = "NULL_VALUE";
+})(NullValue || (NullValue = {}));
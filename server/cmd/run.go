@@ -17,10 +17,14 @@ import (
 	"github.com/llmariner/vector-store-manager/server/internal/embedder"
 	"github.com/llmariner/vector-store-manager/server/internal/milvus"
 	"github.com/llmariner/vector-store-manager/server/internal/ollama"
+	"github.com/llmariner/vector-store-manager/server/internal/pgvector"
+	"github.com/llmariner/vector-store-manager/server/internal/rerank"
 	"github.com/llmariner/vector-store-manager/server/internal/s3"
 	"github.com/llmariner/vector-store-manager/server/internal/server"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/llmariner/vector-store-manager/server/internal/vllm"
+	"github.com/llmariner/vector-store-manager/server/internal/worker"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -105,7 +109,15 @@ func run(ctx context.Context, c *config.Config) error {
 		return err
 	}
 
-	vstoreClient, err := milvus.New(ctx, c.VectorDatabase, logger)
+	var vstoreClient vectordb.Client
+	switch c.VectorDatabaseType {
+	case config.VectorDatabaseTypeMilvus, "":
+		vstoreClient, err = milvus.New(ctx, c.VectorDatabase, st, logger)
+	case config.VectorDatabaseTypePgvector:
+		vstoreClient, err = pgvector.New(ctx, c.VectorDatabase, logger)
+	default:
+		return fmt.Errorf("unsupported vector database type: %s", c.VectorDatabaseType)
+	}
 	if err != nil {
 		return err
 	}
@@ -132,9 +144,36 @@ func run(ctx context.Context, c *config.Config) error {
 	if err != nil {
 		return err
 	}
-	e := embedder.New(llm, s3Client, vstoreClient, logger)
-
-	s := server.New(st, fclient, fwClient, vstoreClient, e, c.Model, dim, logger)
+	var reranker embedder.Reranker
+	if rc := c.Embedder.Reranker; rc.Enable {
+		switch rc.Engine {
+		case config.RerankerEngineTEI:
+			reranker = rerank.NewClient(rc.Addr, rerank.FormatTEI)
+		case config.RerankerEngineCohere:
+			reranker = rerank.NewClient(rc.Addr, rerank.FormatCohere)
+		case config.RerankerEngineVLLM:
+			reranker = rerank.NewVLLMClient(rc.Addr)
+		default:
+			return fmt.Errorf("unsupported reranker engine: %s", rc.Engine)
+		}
+	}
+	e := embedder.New(llm, s3Client, vstoreClient, st, reranker, c.Embedder, logger)
+
+	index := vectordb.Index{
+		MetricType:     vectordb.MetricType(c.MetricType),
+		Type:           vectordb.IndexType(c.VectorIndex.Type),
+		NList:          c.VectorIndex.NList,
+		NProbe:         c.VectorIndex.NProbe,
+		M:              c.VectorIndex.M,
+		NBits:          c.VectorIndex.NBits,
+		EFConstruction: c.VectorIndex.EFConstruction,
+		EF:             c.VectorIndex.EF,
+		SearchList:     c.VectorIndex.SearchList,
+	}.WithDefaults()
+	if err := vstoreClient.ValidateIndex(index, dim); err != nil {
+		return fmt.Errorf("vector index: %s", err)
+	}
+	s := server.New(st, fclient, fwClient, vstoreClient, e, c.Model, dim, index, logger)
 
 	var usageSetter sender.UsageSetter
 	if c.UsageSender.Enable {
@@ -159,9 +198,17 @@ func run(ctx context.Context, c *config.Config) error {
 	}()
 
 	go func() {
-		s := server.NewInternal(c.Model, e, logger)
+		s := server.NewInternal(st, c.Model, e, vstoreClient, logger)
 		errCh <- s.Run(c.InternalGRPCPort)
 	}()
 
+	w, err := worker.New(st, e, c.Worker.NumWorkers, c.Worker.PollingInterval, logger)
+	if err != nil {
+		return err
+	}
+	go func() {
+		errCh <- w.Run(ctx)
+	}()
+
 	return <-errCh
 }
@@ -3,12 +3,35 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/llmariner/api-usage/pkg/sender"
 	"github.com/llmariner/common/pkg/db"
 	"gopkg.in/yaml.v3"
 )
 
+// Defaults of the settings that are not set in the configuration file. They allow configuration files that
+// predate the settings to be used without changes.
+const (
+	defaultNumWorkers      = 2
+	defaultPollingInterval = 10 * time.Second
+
+	defaultBatchSize           = 32
+	defaultNumParallelRequests = 4
+
+	defaultMaxRetries     = 5
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+
+	defaultMaxArchiveMembers        = 1000
+	defaultMaxArchiveTotalSizeBytes = 512 << 20
+
+	defaultEncoding = "cl100k_base"
+
+	defaultRerankerEngine          = "tei"
+	defaultRerankerFetchMultiplier = 4
+)
+
 // AssumeRoleConfig is the assume role configuration.
 type AssumeRoleConfig struct {
 	RoleARN    string `yaml:"roleArn"`
@@ -70,14 +93,304 @@ func (c *AuthConfig) Validate() error {
 	return nil
 }
 
+// WorkerConfig is the configuration of the workers that add files to vector stores.
+type WorkerConfig struct {
+	// NumWorkers is the number of files processed concurrently.
+	NumWorkers int `yaml:"numWorkers"`
+	// PollingInterval is the interval to check queued jobs.
+	PollingInterval time.Duration `yaml:"pollingInterval"`
+}
+
+func (c *WorkerConfig) setDefaults() {
+	if c.NumWorkers == 0 {
+		c.NumWorkers = defaultNumWorkers
+	}
+	if c.PollingInterval == 0 {
+		c.PollingInterval = defaultPollingInterval
+	}
+}
+
+// Validate validates the worker configuration.
+func (c *WorkerConfig) Validate() error {
+	if c.NumWorkers <= 0 {
+		return fmt.Errorf("numWorkers must be greater than 0")
+	}
+	if c.PollingInterval <= 0 {
+		return fmt.Errorf("pollingInterval must be greater than 0")
+	}
+	return nil
+}
+
+// RetryConfig is the configuration of retries for requests to the LLM engine.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries for a failed request.
+	MaxRetries int `yaml:"maxRetries"`
+	// InitialBackoff is the backoff before the first retry. The backoff doubles for every retry.
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	// MaxBackoff is the maximum backoff between retries.
+	MaxBackoff time.Duration `yaml:"maxBackoff"`
+}
+
+func (c *RetryConfig) setDefaults() {
+	if *c == (RetryConfig{}) {
+		// Requests are retried unless the retry section is set with maxRetries of 0.
+		c.MaxRetries = defaultMaxRetries
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = defaultInitialBackoff
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = max(defaultMaxBackoff, c.InitialBackoff)
+	}
+}
+
+func (c *RetryConfig) validate() error {
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("maxRetries must be non-negative")
+	}
+	if c.InitialBackoff <= 0 {
+		return fmt.Errorf("initialBackoff must be greater than 0")
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("maxBackoff must be no less than initialBackoff")
+	}
+	return nil
+}
+
+// ArchiveConfig is the configuration of archive files (.zip, .tar and .tar.gz).
+type ArchiveConfig struct {
+	// MaxMembers is the maximum number of members in an archive.
+	MaxMembers int `yaml:"maxMembers"`
+	// MaxTotalSizeBytes is the maximum total uncompressed size of the members in an archive.
+	MaxTotalSizeBytes int64 `yaml:"maxTotalSizeBytes"`
+}
+
+func (c *ArchiveConfig) setDefaults() {
+	if c.MaxMembers == 0 {
+		c.MaxMembers = defaultMaxArchiveMembers
+	}
+	if c.MaxTotalSizeBytes == 0 {
+		c.MaxTotalSizeBytes = defaultMaxArchiveTotalSizeBytes
+	}
+}
+
+func (c *ArchiveConfig) validate() error {
+	if c.MaxMembers <= 0 {
+		return fmt.Errorf("maxMembers must be greater than 0")
+	}
+	if c.MaxTotalSizeBytes <= 0 {
+		return fmt.Errorf("maxTotalSizeBytes must be greater than 0")
+	}
+	return nil
+}
+
+// supportedEncodings are the BPE encodings that can be used to count tokens.
+var supportedEncodings = map[string]bool{
+	"cl100k_base": true,
+	"p50k_base":   true,
+}
+
+// TokenizerConfig is the configuration of the tokenizers used to split files into chunks.
+type TokenizerConfig struct {
+	// DefaultEncoding is the BPE encoding used for embedding models that are not in ModelEncodings.
+	DefaultEncoding string `yaml:"defaultEncoding"`
+	// ModelEncodings maps embedding model names to BPE encodings.
+	ModelEncodings map[string]string `yaml:"modelEncodings"`
+}
+
+func (c *TokenizerConfig) setDefaults() {
+	if c.DefaultEncoding == "" {
+		c.DefaultEncoding = defaultEncoding
+	}
+}
+
+func (c *TokenizerConfig) validate() error {
+	if !supportedEncodings[c.DefaultEncoding] {
+		return fmt.Errorf("unsupported defaultEncoding %q", c.DefaultEncoding)
+	}
+	for m, e := range c.ModelEncodings {
+		if !supportedEncodings[e] {
+			return fmt.Errorf("unsupported encoding %q for model %q", e, m)
+		}
+	}
+	return nil
+}
+
+const (
+	// RerankerEngineTEI indicates the /rerank endpoint of Text Embeddings Inference.
+	RerankerEngineTEI = "tei"
+	// RerankerEngineCohere indicates a Cohere-compatible /v1/rerank endpoint.
+	RerankerEngineCohere = "cohere"
+	// RerankerEngineVLLM indicates the score API of vLLM.
+	RerankerEngineVLLM = "vllm"
+)
+
+// RerankerConfig is the configuration of the reranker that reorders search results with a cross-encoder model.
+type RerankerConfig struct {
+	Enable bool `yaml:"enable"`
+	// Engine is the engine that serves the reranking model. One of tei, cohere and vllm.
+	Engine string `yaml:"engine"`
+	Addr   string `yaml:"addr"`
+	// Model is the name of the reranking model.
+	Model string `yaml:"model"`
+	// FetchMultiplier is the number of candidates that are reranked for each requested search result.
+	FetchMultiplier int `yaml:"fetchMultiplier"`
+}
+
+func (c *RerankerConfig) setDefaults() {
+	if c.Engine == "" {
+		c.Engine = defaultRerankerEngine
+	}
+	if c.FetchMultiplier == 0 {
+		c.FetchMultiplier = defaultRerankerFetchMultiplier
+	}
+}
+
+func (c *RerankerConfig) validate() error {
+	if !c.Enable {
+		return nil
+	}
+	switch c.Engine {
+	case RerankerEngineTEI, RerankerEngineCohere, RerankerEngineVLLM:
+	default:
+		return fmt.Errorf("unsupported engine %q", c.Engine)
+	}
+	if c.Addr == "" {
+		return fmt.Errorf("addr must be set")
+	}
+	if c.FetchMultiplier <= 0 {
+		return fmt.Errorf("fetchMultiplier must be greater than 0")
+	}
+	return nil
+}
+
+// EmbedderConfig is the configuration of the embedder.
+type EmbedderConfig struct {
+	// BatchSize is the maximum number of chunks sent to the LLM engine in a single embedding request.
+	BatchSize int `yaml:"batchSize"`
+	// NumParallelRequests is the maximum number of embedding requests sent concurrently for a file.
+	NumParallelRequests int `yaml:"numParallelRequests"`
+	// PrependBreadcrumb specifies whether the breadcrumb of the section that a chunk belongs to (e.g., "Install > Helm")
+	// is put in front of the chunk text when the chunk is embedded. The stored chunk text is not changed.
+	PrependBreadcrumb bool `yaml:"prependBreadcrumb"`
+
+	Retry     RetryConfig     `yaml:"retry"`
+	Archive   ArchiveConfig   `yaml:"archive"`
+	Tokenizer TokenizerConfig `yaml:"tokenizer"`
+	Reranker  RerankerConfig  `yaml:"reranker"`
+}
+
+func (c *EmbedderConfig) setDefaults() {
+	if c.BatchSize == 0 {
+		c.BatchSize = defaultBatchSize
+	}
+	if c.NumParallelRequests == 0 {
+		c.NumParallelRequests = defaultNumParallelRequests
+	}
+	c.Retry.setDefaults()
+	c.Archive.setDefaults()
+	c.Tokenizer.setDefaults()
+	c.Reranker.setDefaults()
+}
+
+// Validate validates the embedder configuration.
+func (c *EmbedderConfig) Validate() error {
+	if c.BatchSize <= 0 {
+		return fmt.Errorf("batchSize must be greater than 0")
+	}
+	if c.NumParallelRequests <= 0 {
+		return fmt.Errorf("numParallelRequests must be greater than 0")
+	}
+	if err := c.Retry.validate(); err != nil {
+		return fmt.Errorf("retry: %s", err)
+	}
+	if err := c.Archive.validate(); err != nil {
+		return fmt.Errorf("archive: %s", err)
+	}
+	if err := c.Tokenizer.validate(); err != nil {
+		return fmt.Errorf("tokenizer: %s", err)
+	}
+	if err := c.Reranker.validate(); err != nil {
+		return fmt.Errorf("reranker: %s", err)
+	}
+	return nil
+}
+
 const (
 	// LLMEngineOllama indicates the Ollama LLM engine.
 	LLMEngineOllama = "ollama"
 
 	// LLMEngineVLLM indicates the vLLM LLM engine.
 	LLMEngineVLLM = "vllm"
+
+	// VectorDatabaseTypeMilvus indicates Milvus.
+	VectorDatabaseTypeMilvus = "milvus"
+	// VectorDatabaseTypePgvector indicates PostgreSQL with the pgvector extension.
+	VectorDatabaseTypePgvector = "pgvector"
+
+	// MetricTypeL2 indicates the Euclidean distance.
+	MetricTypeL2 = "l2"
+	// MetricTypeIP indicates the inner product of normalized vectors.
+	MetricTypeIP = "ip"
+	// MetricTypeCosine indicates the cosine similarity.
+	MetricTypeCosine = "cosine"
+
+	// IndexTypeIVFFlat indicates the IVF_FLAT index.
+	IndexTypeIVFFlat = "ivf_flat"
+	// IndexTypeFlat indicates the FLAT index.
+	IndexTypeFlat = "flat"
+	// IndexTypeHNSW indicates the HNSW index.
+	IndexTypeHNSW = "hnsw"
+	// IndexTypeIVFPQ indicates the IVF_PQ index.
+	IndexTypeIVFPQ = "ivf_pq"
+	// IndexTypeDiskANN indicates the DiskANN index.
+	IndexTypeDiskANN = "diskann"
 )
 
+// IndexConfig is the configuration of the index of the vectors in new vector stores. Parameters that are 0
+// use the defaults of the index type.
+type IndexConfig struct {
+	// Type is one of ivf_flat, flat, hnsw, ivf_pq and diskann. Defaults to ivf_flat for milvus and hnsw for
+	// pgvector.
+	Type string `yaml:"type"`
+	// NList is the number of clusters of ivf_flat and ivf_pq.
+	NList int `yaml:"nlist"`
+	// NProbe is the number of clusters that are searched by ivf_flat and ivf_pq.
+	NProbe int `yaml:"nprobe"`
+	// M is the maximum number of edges of each node of hnsw, and the number of sub-vectors of ivf_pq.
+	M int `yaml:"m"`
+	// NBits is the number of bits that encode each sub-vector of ivf_pq.
+	NBits int `yaml:"nbits"`
+	// EFConstruction is the number of candidates that are considered when hnsw is built.
+	EFConstruction int `yaml:"efConstruction"`
+	// EF is the number of candidates that are considered when hnsw is searched.
+	EF int `yaml:"ef"`
+	// SearchList is the number of candidates that are considered when diskann is searched.
+	SearchList int `yaml:"searchList"`
+}
+
+func (c *IndexConfig) validate() error {
+	switch c.Type {
+	case "", IndexTypeIVFFlat, IndexTypeFlat, IndexTypeHNSW, IndexTypeIVFPQ, IndexTypeDiskANN:
+	default:
+		return fmt.Errorf("unsupported type %q", c.Type)
+	}
+	for name, v := range map[string]int{
+		"nlist":          c.NList,
+		"nprobe":         c.NProbe,
+		"m":              c.M,
+		"nbits":          c.NBits,
+		"efConstruction": c.EFConstruction,
+		"ef":             c.EF,
+		"searchList":     c.SearchList,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	return nil
+}
+
 // Config is the configuration.
 type Config struct {
 	GRPCPort         int `yaml:"grpcPort"`
@@ -89,12 +402,21 @@ type Config struct {
 	FileManagerServerAddr         string `yaml:"fileManagerServerAddr"`
 	FileManagerServerInternalAddr string `yaml:"fileManagerServerInternalAddr"`
 
-	VectorDatabase db.Config         `yaml:"vectorDatabase"`
-	Database       db.Config         `yaml:"database"`
-	ObjectStore    ObjectStoreConfig `yaml:"objectStore"`
+	// VectorDatabaseType is the type of the vector database. One of milvus and pgvector. Defaults to milvus.
+	VectorDatabaseType string            `yaml:"vectorDatabaseType"`
+	VectorDatabase     db.Config         `yaml:"vectorDatabase"`
+	Database           db.Config         `yaml:"database"`
+	ObjectStore        ObjectStoreConfig `yaml:"objectStore"`
 
 	// Model is the embedding model name.
 	Model string `yaml:"model"`
+	// MetricType is the default metric of new vector stores. One of l2, ip and cosine. Defaults to l2.
+	MetricType string `yaml:"metricType"`
+	// VectorIndex is the default index of new vector stores.
+	VectorIndex IndexConfig `yaml:"vectorIndex"`
+
+	Embedder EmbedderConfig `yaml:"embedder"`
+	Worker   WorkerConfig   `yaml:"worker"`
 
 	AuthConfig  AuthConfig    `yaml:"auth"`
 	UsageSender sender.Config `yaml:"usageSender"`
@@ -132,6 +454,12 @@ func (c *Config) Validate() error {
 	if err := c.ObjectStore.Validate(); err != nil {
 		return fmt.Errorf("object store: %s", err)
 	}
+	if err := c.Embedder.Validate(); err != nil {
+		return fmt.Errorf("embedder: %s", err)
+	}
+	if err := c.Worker.Validate(); err != nil {
+		return fmt.Errorf("worker: %s", err)
+	}
 	if err := c.AuthConfig.Validate(); err != nil {
 		return err
 	}
@@ -144,11 +472,35 @@ func (c *Config) Validate() error {
 	default:
 		return fmt.Errorf("unsupported llm engine: %q", c.LLMEngine)
 	}
+	switch c.VectorDatabaseType {
+	case "", VectorDatabaseTypeMilvus, VectorDatabaseTypePgvector:
+	default:
+		return fmt.Errorf("unsupported vector database type: %q", c.VectorDatabaseType)
+	}
+	switch c.MetricType {
+	case "", MetricTypeL2, MetricTypeIP, MetricTypeCosine:
+	default:
+		return fmt.Errorf("unsupported metric type: %q", c.MetricType)
+	}
+	if err := c.VectorIndex.validate(); err != nil {
+		return fmt.Errorf("vector index: %s", err)
+	}
 	return nil
 }
 
+// setDefaults sets the defaults of the settings that are not set.
+func (c *Config) setDefaults() {
+	c.Embedder.setDefaults()
+	c.Worker.setDefaults()
+	if c.VectorDatabaseType == VectorDatabaseTypePgvector && c.VectorIndex.Type == "" {
+		// pgvector builds the index of a new vector store on the empty table, and an ivf_flat index built
+		// without vectors has no useful clusters.
+		c.VectorIndex.Type = IndexTypeHNSW
+	}
+}
+
 // Parse parses the configuration file at the given path, returning a new
-// Config struct.
+// Config struct. Settings that are not set take their defaults.
 func Parse(path string) (Config, error) {
 	var config Config
 
@@ -160,5 +512,6 @@ func Parse(path string) (Config, error) {
 	if err = yaml.Unmarshal(b, &config); err != nil {
 		return config, fmt.Errorf("config: unmarshal: %s", err)
 	}
+	config.setDefaults()
 	return config, nil
 }
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Defaults(t *testing.T) {
+	tcs := []struct {
+		name         string
+		config       string
+		wantEmbedder EmbedderConfig
+		wantWorker   WorkerConfig
+	}{
+		{
+			name:   "no sections",
+			config: `model: model0`,
+			wantEmbedder: EmbedderConfig{
+				BatchSize:           32,
+				NumParallelRequests: 4,
+				Retry: RetryConfig{
+					MaxRetries:     5,
+					InitialBackoff: time.Second,
+					MaxBackoff:     30 * time.Second,
+				},
+				Archive: ArchiveConfig{
+					MaxMembers:        1000,
+					MaxTotalSizeBytes: 512 << 20,
+				},
+				Tokenizer: TokenizerConfig{DefaultEncoding: "cl100k_base"},
+				Reranker:  RerankerConfig{Engine: "tei", FetchMultiplier: 4},
+			},
+			wantWorker: WorkerConfig{
+				NumWorkers:      2,
+				PollingInterval: 10 * time.Second,
+			},
+		},
+		{
+			name: "set values are kept",
+			config: `
+embedder:
+  batchSize: 8
+  retry:
+    maxRetries: 0
+    initialBackoff: 1m
+  tokenizer:
+    defaultEncoding: p50k_base
+worker:
+  numWorkers: 1
+`,
+			wantEmbedder: EmbedderConfig{
+				BatchSize:           8,
+				NumParallelRequests: 4,
+				Retry: RetryConfig{
+					MaxRetries:     0,
+					InitialBackoff: time.Minute,
+					MaxBackoff:     time.Minute,
+				},
+				Archive: ArchiveConfig{
+					MaxMembers:        1000,
+					MaxTotalSizeBytes: 512 << 20,
+				},
+				Tokenizer: TokenizerConfig{DefaultEncoding: "p50k_base"},
+				Reranker:  RerankerConfig{Engine: "tei", FetchMultiplier: 4},
+			},
+			wantWorker: WorkerConfig{
+				NumWorkers:      1,
+				PollingInterval: 10 * time.Second,
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			err := os.WriteFile(path, []byte(tc.config), 0o644)
+			assert.NoError(t, err)
+
+			c, err := Parse(path)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantEmbedder, c.Embedder)
+			assert.Equal(t, tc.wantWorker, c.Worker)
+			assert.NoError(t, c.Embedder.Validate())
+			assert.NoError(t, c.Worker.Validate())
+		})
+	}
+}
+
+func TestParse_VectorIndexDefaults(t *testing.T) {
+	tcs := []struct {
+		name     string
+		config   string
+		wantType string
+	}{
+		{
+			name:     "milvus",
+			config:   `model: model0`,
+			wantType: "",
+		},
+		{
+			name:     "pgvector",
+			config:   `vectorDatabaseType: pgvector`,
+			wantType: IndexTypeHNSW,
+		},
+		{
+			name: "pgvector with type",
+			config: `
+vectorDatabaseType: pgvector
+vectorIndex:
+  type: flat
+`,
+			wantType: IndexTypeFlat,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			err := os.WriteFile(path, []byte(tc.config), 0o644)
+			assert.NoError(t, err)
+
+			c, err := Parse(path)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantType, c.VectorIndex.Type)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package embedder
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+const (
+	// metadataKeyMemberPath is the metadata key of the path of the archive member that a chunk comes from.
+	metadataKeyMemberPath = "member_path"
+
+	// maxReportedMemberErrors is the maximum number of member errors included in an error message.
+	maxReportedMemberErrors = 10
+)
+
+// MemberError is an error that occurred while loading a member of an archive.
+type MemberError struct {
+	Path string
+	Err  error
+}
+
+// PartialArchiveError is returned when some members of an archive could not be loaded.
+// The documents of the other members are still added.
+type PartialArchiveError struct {
+	MemberErrors []MemberError
+}
+
+// Error implements the error interface.
+func (e *PartialArchiveError) Error() string {
+	var msgs []string
+	for i, m := range e.MemberErrors {
+		if i == maxReportedMemberErrors {
+			msgs = append(msgs, fmt.Sprintf("and %d more", len(e.MemberErrors)-i))
+			break
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: %s", m.Path, m.Err))
+	}
+	return fmt.Sprintf("failed to load %d archive member(s): %s", len(e.MemberErrors), strings.Join(msgs, "; "))
+}
+
+// fileTypeOf returns the lower-cased extension of the file name. ".tar.gz" is treated as a single extension.
+func fileTypeOf(fileName string) string {
+	lower := strings.ToLower(fileName)
+	if strings.HasSuffix(lower, ".tar.gz") {
+		return ".tar.gz"
+	}
+	return filepath.Ext(lower)
+}
+
+func isArchive(fileType string) bool {
+	switch fileType {
+	case ".zip", ".tar", ".tar.gz", ".tgz":
+		return true
+	default:
+		return false
+	}
+}
+
+// splitArchive splits every supported member of the archive into chunks. The member path is recorded
+// in the metadata of each chunk. Members that cannot be loaded are returned as MemberErrors.
+// Members of unsupported types, including nested archives, are skipped, but they still count
+// towards the limits. The chunking of each member is resolved from its own type.
+func (e *E) splitArchive(
+	ctx context.Context,
+	fileName,
+	fileType string,
+	tok *tokenizer,
+	cs ChunkingStrategy,
+	semantic textsplitter.TextSplitter,
+) ([]schema.Document, []MemberError, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	log.Info("Splitting archive members into chunks")
+
+	var (
+		docs        []schema.Document
+		memberErrs  []MemberError
+		numMembers  int
+		remainBytes = e.archive.MaxTotalSizeBytes
+	)
+	walkFn := func(name string, r io.Reader) error {
+		if isHiddenMember(name) {
+			return nil
+		}
+		numMembers++
+		if numMembers > e.archive.MaxMembers {
+			return fmt.Errorf("archive has more than %d members", e.archive.MaxMembers)
+		}
+
+		f, err := os.CreateTemp("/tmp", "rag-member-")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := os.Remove(f.Name()); err != nil {
+				log.Error(err, "Failed to remove")
+			}
+		}()
+		// Read one more byte than the remaining budget to detect archives that exceed the limit.
+		n, err := io.Copy(f, io.LimitReader(r, remainBytes+1))
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return fmt.Errorf("extract %s: %s", name, err)
+		}
+		if n > remainBytes {
+			return fmt.Errorf("total uncompressed size of archive exceeds %d bytes", e.archive.MaxTotalSizeBytes)
+		}
+		remainBytes -= n
+
+		memberType, err := detectFileType(f.Name(), name)
+		if err != nil && !errors.Is(err, ErrUnsupportedFileType) {
+			return fmt.Errorf("detect file type of %s: %s", name, err)
+		}
+		if err != nil || isArchive(memberType) {
+			log.V(1).Info("Skipped unsupported archive member", "member", name)
+			return nil
+		}
+
+		chunking := resolveChunking(memberType, cs)
+		mdocs, err := splitFile(ctx, f.Name(), memberType, tok, chunking.MaxChunkSizeTokens, chunking.ChunkOverlapTokens, semantic)
+		if err != nil {
+			log.Info("Failed to load archive member", "member", name, "error", err.Error())
+			memberErrs = append(memberErrs, MemberError{Path: name, Err: err})
+			return nil
+		}
+		for _, d := range mdocs {
+			if d.Metadata == nil {
+				d.Metadata = map[string]any{}
+			}
+			d.Metadata[metadataKeyMemberPath] = name
+			docs = append(docs, d)
+		}
+		return nil
+	}
+
+	var err error
+	switch fileType {
+	case ".zip":
+		err = walkZip(fileName, walkFn)
+	case ".tar":
+		err = walkTar(fileName, false, walkFn)
+	case ".tar.gz", ".tgz":
+		err = walkTar(fileName, true, walkFn)
+	default:
+		err = fmt.Errorf("unexpected archive type: fileType=%q", fileType)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return docs, memberErrs, nil
+}
+
+func walkZip(fileName string, fn func(name string, r io.Reader) error) error {
+	zr, err := zip.OpenReader(fileName)
+	if err != nil {
+		return fmt.Errorf("open zip: %s", err)
+	}
+	defer func() {
+		_ = zr.Close()
+	}()
+
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		if err := walkZipFile(f, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkZipFile(f *zip.File, fn func(name string, r io.Reader) error) error {
+	r, err := f.Open()
+	if err != nil {
+		return fmt.Errorf("open %s: %s", f.Name, err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	return fn(f.Name, r)
+}
+
+func walkTar(fileName string, gzipped bool, fn func(name string, r io.Reader) error) error {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var r io.Reader = f
+	if gzipped {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("open gzip: %s", err)
+		}
+		defer func() {
+			_ = gr.Close()
+		}()
+		r = gr
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read tar: %s", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if err := fn(hdr.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// isHiddenMember returns true for dot files and the resource forks that macOS adds to zip files.
+func isHiddenMember(name string) bool {
+	for _, p := range strings.Split(path.Clean(name), "/") {
+		if (strings.HasPrefix(p, ".") && p != "." && p != "..") || p == "__MACOSX" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddFile_Archive(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+	tcs := []struct {
+		name            string
+		fileName        string
+		archive         config.ArchiveConfig
+		wantMembers     []string
+		wantFailed      []string
+		wantErr         bool
+		wantErrContains string
+	}{
+		{
+			name:        "zip",
+			fileName:    "test.zip",
+			archive:     config.ArchiveConfig{MaxMembers: 10, MaxTotalSizeBytes: 1024},
+			wantMembers: []string{"docs/guide.md", "docs/notes.txt"},
+			wantFailed:  []string{"docs/broken.pdf"},
+		},
+		{
+			name:        "tar.gz",
+			fileName:    "test.tar.gz",
+			archive:     config.ArchiveConfig{MaxMembers: 10, MaxTotalSizeBytes: 1024},
+			wantMembers: []string{"docs/guide.md", "docs/notes.txt"},
+		},
+		{
+			name:            "too many members",
+			fileName:        "test.zip",
+			archive:         config.ArchiveConfig{MaxMembers: 2, MaxTotalSizeBytes: 1024},
+			wantErr:         true,
+			wantErrContains: "more than 2 members",
+		},
+		{
+			name:            "too large",
+			fileName:        "test.tar.gz",
+			archive:         config.ArchiveConfig{MaxMembers: 10, MaxTotalSizeBytes: 50},
+			wantErr:         true,
+			wantErrContains: "exceeds 50 bytes",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			vs := &noopVStoreClient{collectionName: collectionName}
+			cfg := newTestConfig(10)
+			cfg.Archive = tc.archive
+			e := New(
+				&noopLLMClient{},
+				&fileS3Client{path: "testdata/" + tc.fileName},
+				vs,
+				&noopParentChunkStore{},
+				nil, // reranker
+				cfg,
+				testr.New(t),
+			)
+			chunking, err := e.AddFile(context.Background(), collectionName, modelName, vectordb.MetricTypeL2, false, "file0", tc.fileName, "key", newStaticChunkingStrategy(100, 10), nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErrContains)
+				assert.Empty(t, vs.texts)
+				return
+			}
+			assert.Equal(t, splitterArchive, chunking.Splitter)
+
+			if len(tc.wantFailed) > 0 {
+				var perr *PartialArchiveError
+				assert.True(t, errors.As(err, &perr))
+				var failed []string
+				for _, m := range perr.MemberErrors {
+					failed = append(failed, m.Path)
+				}
+				assert.Equal(t, tc.wantFailed, failed)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var members []string
+			for _, m := range vs.metadatas {
+				members = append(members, m[metadataKeyMemberPath].(string))
+			}
+			assert.Equal(t, tc.wantMembers, members)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package embedder
+
+import "strings"
+
+// ChunkingStrategyType is the type of a chunking strategy.
+type ChunkingStrategyType string
+
+const (
+	// ChunkingStrategyTypeAuto chooses the chunk sizes based on the type of the file.
+	ChunkingStrategyTypeAuto ChunkingStrategyType = "auto"
+	// ChunkingStrategyTypeStatic uses the chunk sizes given by the user.
+	ChunkingStrategyTypeStatic ChunkingStrategyType = "static"
+	// ChunkingStrategyTypeSemantic starts a new chunk where the similarity between neighboring sentences drops.
+	ChunkingStrategyTypeSemantic ChunkingStrategyType = "semantic"
+	// ChunkingStrategyTypeParentChild embeds small child chunks and returns their larger parent chunks in search results.
+	ChunkingStrategyTypeParentChild ChunkingStrategyType = "parent_child"
+)
+
+// ChunkingStrategy specifies how a file is split into chunks.
+type ChunkingStrategy struct {
+	Type ChunkingStrategyType
+	// MaxChunkSizeTokens is used by the static and semantic chunking strategies. It is the maximum size
+	// of child chunks for the parent-child chunking strategy.
+	MaxChunkSizeTokens int64
+	// ChunkOverlapTokens is used only by the static chunking strategy.
+	ChunkOverlapTokens int64
+	// BreakpointPercentile is used only by the semantic chunking strategy.
+	BreakpointPercentile int32
+	// MaxParentChunkSizeTokens is used only by the parent-child chunking strategy.
+	MaxParentChunkSizeTokens int64
+}
+
+// Chunking is the chunking that was used to split a file.
+type Chunking struct {
+	// Splitter is the name of the splitter (e.g., markdown_headings or pdf_pages).
+	Splitter           string
+	MaxChunkSizeTokens int64
+	ChunkOverlapTokens int64
+}
+
+// Names of the splitters. A splitter is chosen based on the type of the file.
+const (
+	splitterRecursive        = "recursive"
+	splitterMarkdownHeadings = "markdown_headings"
+	splitterHTMLHeadings     = "html_headings"
+	splitterPDFPages         = "pdf_pages"
+	splitterCSVRows          = "csv_rows"
+	splitterJSONRecords      = "json_records"
+	splitterCode             = "code"
+	splitterDOCXParagraphs   = "docx_paragraphs"
+	splitterSlides           = "slides"
+	splitterSheetRows        = "sheet_rows"
+	splitterSemantic         = "semantic"
+	// splitterArchive is reported for archives. Each member is split with the splitter for its type.
+	splitterArchive = "archive"
+)
+
+type chunkSizes struct {
+	maxChunkSizeTokens int64
+	chunkOverlapTokens int64
+}
+
+// autoChunkSizes are the chunk sizes used by the auto chunking strategy.
+//
+// Documents with a structure (sections, pages, slides) are split at the structure first, so chunks
+// need less overlap to keep their context. Records (CSV rows, JSON records, spreadsheet rows) and
+// source code are independent units and are not overlapped.
+var autoChunkSizes = map[string]chunkSizes{
+	splitterRecursive:        {maxChunkSizeTokens: 800, chunkOverlapTokens: 400},
+	splitterMarkdownHeadings: {maxChunkSizeTokens: 800, chunkOverlapTokens: 200},
+	splitterHTMLHeadings:     {maxChunkSizeTokens: 800, chunkOverlapTokens: 200},
+	splitterPDFPages:         {maxChunkSizeTokens: 800, chunkOverlapTokens: 200},
+	splitterDOCXParagraphs:   {maxChunkSizeTokens: 800, chunkOverlapTokens: 200},
+	splitterSlides:           {maxChunkSizeTokens: 400, chunkOverlapTokens: 0},
+	splitterCSVRows:          {maxChunkSizeTokens: 400, chunkOverlapTokens: 0},
+	splitterJSONRecords:      {maxChunkSizeTokens: 400, chunkOverlapTokens: 0},
+	splitterSheetRows:        {maxChunkSizeTokens: 800, chunkOverlapTokens: 0},
+	splitterCode:             {maxChunkSizeTokens: 800, chunkOverlapTokens: 0},
+}
+
+// splitterOf returns the name of the splitter that splitFile uses for the file type.
+func splitterOf(fileType string) string {
+	switch strings.ToLower(fileType) {
+	case ".pdf":
+		return splitterPDFPages
+	case ".html":
+		return splitterHTMLHeadings
+	case ".md", ".markdown":
+		return splitterMarkdownHeadings
+	case ".csv":
+		return splitterCSVRows
+	case ".json", ".jsonl":
+		return splitterJSONRecords
+	case ".docx":
+		return splitterDOCXParagraphs
+	case ".pptx":
+		return splitterSlides
+	case ".xlsx":
+		return splitterSheetRows
+	default:
+		if isCode(strings.ToLower(fileType)) {
+			return splitterCode
+		}
+		if isArchive(fileType) {
+			return splitterArchive
+		}
+		return splitterRecursive
+	}
+}
+
+// resolveChunking returns the chunking for a file of the given type. The chunk sizes of archives
+// are resolved for each member, so they are left unset for the auto chunking strategy.
+//
+// The semantic chunking strategy is not applied to source code, which does not consist of sentences.
+// Source code is split with the code splitter without overlaps instead.
+func resolveChunking(fileType string, cs ChunkingStrategy) Chunking {
+	c := Chunking{
+		Splitter: splitterOf(fileType),
+	}
+	switch cs.Type {
+	case ChunkingStrategyTypeStatic:
+		c.MaxChunkSizeTokens = cs.MaxChunkSizeTokens
+		c.ChunkOverlapTokens = cs.ChunkOverlapTokens
+		return c
+	case ChunkingStrategyTypeSemantic:
+		if c.Splitter != splitterCode && c.Splitter != splitterArchive {
+			c.Splitter = splitterSemantic
+		}
+		c.MaxChunkSizeTokens = cs.MaxChunkSizeTokens
+		return c
+	case ChunkingStrategyTypeParentChild:
+		// Parent chunks are split with the splitter for the file type, and child chunks are split from
+		// parent chunks without overlaps.
+		c.MaxChunkSizeTokens = cs.MaxChunkSizeTokens
+		return c
+	}
+	if s, ok := autoChunkSizes[c.Splitter]; ok {
+		c.MaxChunkSizeTokens = s.maxChunkSizeTokens
+		c.ChunkOverlapTokens = s.chunkOverlapTokens
+	}
+	return c
+}
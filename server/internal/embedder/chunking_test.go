@@ -0,0 +1,90 @@
+package embedder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveChunking(t *testing.T) {
+	tcs := []struct {
+		name     string
+		fileType string
+		cs       ChunkingStrategy
+		want     Chunking
+	}{
+		{
+			name:     "auto text",
+			fileType: ".txt",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeAuto},
+			want:     Chunking{Splitter: splitterRecursive, MaxChunkSizeTokens: 800, ChunkOverlapTokens: 400},
+		},
+		{
+			name:     "auto markdown",
+			fileType: ".md",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeAuto},
+			want:     Chunking{Splitter: splitterMarkdownHeadings, MaxChunkSizeTokens: 800, ChunkOverlapTokens: 200},
+		},
+		{
+			name:     "auto html",
+			fileType: ".html",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeAuto},
+			want:     Chunking{Splitter: splitterHTMLHeadings, MaxChunkSizeTokens: 800, ChunkOverlapTokens: 200},
+		},
+		{
+			name:     "auto pdf",
+			fileType: ".pdf",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeAuto},
+			want:     Chunking{Splitter: splitterPDFPages, MaxChunkSizeTokens: 800, ChunkOverlapTokens: 200},
+		},
+		{
+			name:     "auto csv",
+			fileType: ".csv",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeAuto},
+			want:     Chunking{Splitter: splitterCSVRows, MaxChunkSizeTokens: 400},
+		},
+		{
+			name:     "auto code",
+			fileType: ".go",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeAuto},
+			want:     Chunking{Splitter: splitterCode, MaxChunkSizeTokens: 800},
+		},
+		{
+			name:     "auto archive",
+			fileType: ".zip",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeAuto},
+			want:     Chunking{Splitter: splitterArchive},
+		},
+		{
+			name:     "semantic",
+			fileType: ".pdf",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeSemantic, MaxChunkSizeTokens: 500, BreakpointPercentile: 5},
+			want:     Chunking{Splitter: splitterSemantic, MaxChunkSizeTokens: 500},
+		},
+		{
+			name:     "semantic code",
+			fileType: ".py",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeSemantic, MaxChunkSizeTokens: 500, BreakpointPercentile: 5},
+			want:     Chunking{Splitter: splitterCode, MaxChunkSizeTokens: 500},
+		},
+		{
+			name:     "static",
+			fileType: ".md",
+			cs:       newStaticChunkingStrategy(300, 100),
+			want:     Chunking{Splitter: splitterMarkdownHeadings, MaxChunkSizeTokens: 300, ChunkOverlapTokens: 100},
+		},
+		{
+			name:     "parent child",
+			fileType: ".md",
+			cs:       ChunkingStrategy{Type: ChunkingStrategyTypeParentChild, MaxChunkSizeTokens: 200, MaxParentChunkSizeTokens: 2000},
+			want:     Chunking{Splitter: splitterMarkdownHeadings, MaxChunkSizeTokens: 200},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got := resolveChunking(tc.fileType, tc.cs)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,431 @@
+package embedder
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+const (
+	// metadataKeySymbol is the metadata key of the name of the declaration in a chunk of source code.
+	metadataKeySymbol = "symbol"
+	// metadataKeyStartLine and metadataKeyEndLine are the metadata keys of the 1-based line range
+	// of a chunk of source code.
+	metadataKeyStartLine = "start_line"
+	metadataKeyEndLine   = "end_line"
+)
+
+// codeSyntax describes the syntax of a programming language that the code splitter needs.
+type codeSyntax struct {
+	// lineComment starts a comment that ends at the end of the line.
+	lineComment string
+	// blockComments is true if the language has /* */ comments.
+	blockComments bool
+	// tripleQuotes is true if the language has """ and ''' strings that can span lines.
+	tripleQuotes bool
+	// quotes are the characters that enclose string and character literals.
+	quotes string
+	// prefixes are the prefixes of lines that belong to the next declaration (e.g., doc comments and decorators).
+	prefixes []string
+	// separators split declarations that are larger than the chunk size.
+	separators []string
+}
+
+var (
+	goSeparators = []string{
+		"\nfunc ",
+		"\ntype ",
+		"\nvar ",
+		"\nconst ",
+		"\n\n",
+		"\n",
+		" ",
+		"",
+	}
+	pythonSeparators = []string{
+		"\nclass ",
+		"\ndef ",
+		"\n\tdef ",
+		"\n    def ",
+		"\n\n",
+		"\n",
+		" ",
+		"",
+	}
+	genericCodeSeparators = []string{
+		"\n\n",
+		"\n",
+		" ",
+		"",
+	}
+
+	cLikeSyntax = codeSyntax{
+		lineComment:   "//",
+		blockComments: true,
+		quotes:        "\"'`",
+		prefixes:      []string{"//", "/*", "*", "@"},
+		separators:    genericCodeSeparators,
+	}
+
+	// codeSyntaxes are the syntaxes of the supported programming languages keyed by file type.
+	codeSyntaxes = map[string]codeSyntax{
+		".go": {
+			lineComment:   "//",
+			blockComments: true,
+			quotes:        "\"'`",
+			prefixes:      []string{"//", "/*", "*"},
+			separators:    goSeparators,
+		},
+		".py": {
+			lineComment:  "#",
+			tripleQuotes: true,
+			quotes:       "\"'",
+			prefixes:     []string{"#", "@"},
+			separators:   pythonSeparators,
+		},
+		".js":   cLikeSyntax,
+		".ts":   cLikeSyntax,
+		".java": cLikeSyntax,
+		".c":    cLikeSyntax,
+		".h":    cLikeSyntax,
+		".cpp":  cLikeSyntax,
+		// Single quotes are not treated as quotes in Rust as they are also used for lifetimes.
+		".rs": {
+			lineComment:   "//",
+			blockComments: true,
+			quotes:        "\"",
+			prefixes:      []string{"//", "/*", "*", "#["},
+			separators:    genericCodeSeparators,
+		},
+	}
+)
+
+var (
+	// declarationRE matches the keyword-introduced declarations of common languages.
+	declarationRE = regexp.MustCompile(`\b(?:func|def|class|function|fn|struct|enum|interface|trait|impl|type|record)\s+([A-Za-z_]\w*)`)
+	// callableRE matches the name of a C-like function or method in its signature.
+	callableRE = regexp.MustCompile(`([A-Za-z_]\w*)\s*\(`)
+)
+
+func isCode(fileType string) bool {
+	_, ok := codeSyntaxes[fileType]
+	return ok
+}
+
+// codeUnit is a top-level declaration in source code.
+type codeUnit struct {
+	symbol string
+	// start and end are the byte offsets of the declaration.
+	start, end int
+}
+
+// splitCode splits source code into chunks at top-level declarations. Go code is parsed with go/parser.
+// Other languages and Go code that cannot be parsed are split with a heuristic based on brackets and indentation.
+//
+// Each chunk has the symbol name and the line range in its metadata. Declarations that are larger than
+// the chunk size are split further with the separators of the language.
+func splitCode(r io.Reader, fileType string, splitter textsplitter.RecursiveCharacter) ([]schema.Document, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	src := string(b)
+	syntax, ok := codeSyntaxes[fileType]
+	if !ok {
+		return nil, fmt.Errorf("%w: fileType=%q", ErrUnsupportedFileType, fileType)
+	}
+
+	var units []codeUnit
+	if fileType == ".go" {
+		units, err = goCodeUnits(src)
+	}
+	if fileType != ".go" || err != nil {
+		units = heuristicCodeUnits(src, syntax)
+	}
+
+	splitter.Separators = syntax.separators
+	splitter.KeepSeparator = true
+	return splitCodeUnits(src, units, splitter)
+}
+
+func splitCodeUnits(src string, units []codeUnit, splitter textsplitter.RecursiveCharacter) ([]schema.Document, error) {
+	var docs []schema.Document
+	for _, u := range units {
+		text := src[u.start:u.end]
+		start := u.start + len(text) - len(strings.TrimLeftFunc(text, unicode.IsSpace))
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		startLine := 1 + strings.Count(src[:start], "\n")
+
+		var parts []string
+		if splitter.LenFunc(text) <= splitter.ChunkSize {
+			parts = []string{text}
+		} else {
+			var err error
+			if parts, err = splitter.SplitText(text); err != nil {
+				return nil, err
+			}
+		}
+
+		// Locate each part in the declaration to compute its line range. Parts are searched in order
+		// as they can overlap.
+		offset := 0
+		for _, p := range parts {
+			p = strings.TrimSpace(p)
+			if p == "" {
+				continue
+			}
+			first, last := startLine, startLine+strings.Count(text, "\n")
+			if i := strings.Index(text[offset:], p); i >= 0 {
+				first = startLine + strings.Count(text[:offset+i], "\n")
+				last = first + strings.Count(p, "\n")
+				offset += i + 1
+			}
+			metadata := map[string]any{
+				metadataKeyStartLine: first,
+				metadataKeyEndLine:   last,
+			}
+			if u.symbol != "" {
+				metadata[metadataKeySymbol] = u.symbol
+			}
+			docs = append(docs, schema.Document{
+				PageContent: p,
+				Metadata:    metadata,
+			})
+		}
+	}
+	return docs, nil
+}
+
+// goCodeUnits splits Go source code into the package clause with the imports and the top-level declarations.
+// Comments between declarations belong to the next declaration, and comments after the last declaration
+// belong to the last declaration.
+func goCodeUnits(src string) ([]codeUnit, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	tf := fset.File(f.Package)
+	offset := func(p token.Pos) int {
+		return tf.Offset(p)
+	}
+
+	headerEnd := f.Name.End()
+	var decls []ast.Decl
+	for _, d := range f.Decls {
+		if gd, ok := d.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+			headerEnd = gd.End()
+			continue
+		}
+		decls = append(decls, d)
+	}
+
+	units := []codeUnit{
+		{symbol: "package " + f.Name.Name, start: 0, end: offset(headerEnd)},
+	}
+	for _, d := range decls {
+		units = append(units, codeUnit{
+			symbol: goSymbol(d),
+			start:  units[len(units)-1].end,
+			end:    offset(d.End()),
+		})
+	}
+	units[len(units)-1].end = len(src)
+	return units, nil
+}
+
+// goSymbol returns the name of a Go declaration. Methods are named as "T.Method" or "(*T).Method".
+func goSymbol(d ast.Decl) string {
+	switch d := d.(type) {
+	case *ast.FuncDecl:
+		if d.Recv == nil || len(d.Recv.List) == 0 {
+			return d.Name.Name
+		}
+		recv := types.ExprString(d.Recv.List[0].Type)
+		if strings.HasPrefix(recv, "*") {
+			return fmt.Sprintf("(%s).%s", recv, d.Name.Name)
+		}
+		return recv + "." + d.Name.Name
+	case *ast.GenDecl:
+		var names []string
+		for _, s := range d.Specs {
+			switch s := s.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, n := range s.Names {
+					names = append(names, n.Name)
+				}
+			}
+		}
+		return strings.Join(names, ", ")
+	default:
+		return ""
+	}
+}
+
+// heuristicCodeUnits splits source code at lines that start a top-level declaration. A line starts
+// a declaration if it is not indented and it is outside of brackets, block comments and multi-line strings.
+// Comments and decorators right before a declaration belong to the declaration. Consecutive statements
+// without a symbol (e.g., imports) are kept together.
+func heuristicCodeUnits(src string, syntax codeSyntax) []codeUnit {
+	var (
+		units []codeUnit
+		sc    = codeScanner{syntax: syntax}
+		// start is the offset of the current unit.
+		start int
+		// hasCode is true if the current unit has a line other than comments and decorators.
+		hasCode bool
+		// prefixStart is the offset of the comments and decorators at the end of the current unit.
+		prefixStart = -1
+		offset      int
+	)
+	appendUnit := func(end int) {
+		u := codeUnit{symbol: codeSymbol(src[start:end], syntax), start: start, end: end}
+		if n := len(units); n > 0 && units[n-1].symbol == "" && u.symbol == "" {
+			units[n-1].end = end
+		} else {
+			units = append(units, u)
+		}
+		start = end
+	}
+
+	for _, line := range strings.SplitAfter(src, "\n") {
+		lineStart := offset
+		offset += len(line)
+		trimmed := strings.TrimSpace(line)
+		inComment := sc.inBlockComment || sc.tripleQuote != ""
+		topLevel := sc.atTopLevel() && trimmed != "" && !unicode.IsSpace(rune(line[0])) && !isContinuationLine(trimmed)
+		sc.scan(line)
+		if trimmed == "" {
+			continue
+		}
+		if !topLevel {
+			if !inComment && !syntax.isPrefix(trimmed) {
+				// The comments and decorators before this line are a part of the current declaration.
+				hasCode = true
+				prefixStart = -1
+			}
+			continue
+		}
+		if syntax.isPrefix(trimmed) {
+			if hasCode && prefixStart < 0 {
+				prefixStart = lineStart
+			}
+			continue
+		}
+		if hasCode {
+			end := lineStart
+			if prefixStart >= 0 {
+				end = prefixStart
+			}
+			appendUnit(end)
+		}
+		hasCode = true
+		prefixStart = -1
+	}
+	if start < len(src) {
+		appendUnit(len(src))
+	}
+	return units
+}
+
+func (s codeSyntax) isPrefix(line string) bool {
+	for _, p := range s.prefixes {
+		if strings.HasPrefix(line, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// isContinuationLine returns true if the line continues the previous declaration, such as
+// a line that closes a block or a brace on its own line.
+func isContinuationLine(line string) bool {
+	return strings.ContainsAny(line[:1], "{}])")
+}
+
+// codeSymbol returns the name of the first declaration in the code or an empty string.
+func codeSymbol(code string, syntax codeSyntax) string {
+	for _, line := range strings.Split(code, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || syntax.isPrefix(trimmed) {
+			continue
+		}
+		if m := declarationRE.FindStringSubmatch(trimmed); m != nil {
+			return m[1]
+		}
+		// C-like functions and methods have no keyword. Statements such as assignments and calls are not declarations.
+		if syntax.blockComments && strings.Contains(code, "{") && !strings.ContainsAny(trimmed, "=;") {
+			if m := callableRE.FindStringSubmatch(trimmed); m != nil {
+				return m[1]
+			}
+		}
+		return ""
+	}
+	return ""
+}
+
+// codeScanner tracks brackets, block comments and multi-line strings across lines.
+type codeScanner struct {
+	syntax codeSyntax
+
+	depth          int
+	inBlockComment bool
+	// tripleQuote is the quote of the multi-line string that the scanner is in.
+	tripleQuote string
+}
+
+func (s *codeScanner) atTopLevel() bool {
+	return s.depth <= 0 && !s.inBlockComment && s.tripleQuote == ""
+}
+
+func (s *codeScanner) scan(line string) {
+	for i := 0; i < len(line); i++ {
+		rest := line[i:]
+		switch {
+		case s.inBlockComment:
+			if strings.HasPrefix(rest, "*/") {
+				s.inBlockComment = false
+				i++
+			}
+		case s.tripleQuote != "":
+			if strings.HasPrefix(rest, s.tripleQuote) {
+				s.tripleQuote = ""
+				i += 2
+			}
+		case s.syntax.lineComment != "" && strings.HasPrefix(rest, s.syntax.lineComment):
+			return
+		case s.syntax.blockComments && strings.HasPrefix(rest, "/*"):
+			s.inBlockComment = true
+			i++
+		case s.syntax.tripleQuotes && (strings.HasPrefix(rest, `"""`) || strings.HasPrefix(rest, `'''`)):
+			s.tripleQuote = rest[:3]
+			i += 2
+		case strings.IndexByte(s.syntax.quotes, line[i]) >= 0:
+			// Skip a string literal. Unterminated literals end at the end of the line.
+			q := line[i]
+			for i++; i < len(line) && line[i] != q; i++ {
+				if line[i] == '\\' {
+					i++
+				}
+			}
+		case strings.ContainsRune("{[(", rune(line[i])):
+			s.depth++
+		case strings.ContainsRune("}])", rune(line[i])):
+			s.depth--
+		}
+	}
+}
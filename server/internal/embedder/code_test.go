@@ -0,0 +1,216 @@
+package embedder
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+func TestSplitCode(t *testing.T) {
+	type chunk struct {
+		text      string
+		symbol    string
+		startLine int
+		endLine   int
+	}
+	tcs := []struct {
+		name     string
+		fileType string
+		src      string
+		want     []chunk
+	}{
+		{
+			name:     "go",
+			fileType: ".go",
+			src: `// Package store stores files.
+package store
+
+import (
+	"errors"
+)
+
+// ErrNotFound is returned when a file is not found.
+var ErrNotFound = errors.New("not found")
+
+const (
+	a = 1
+	b = 2
+)
+
+// S is a store.
+type S struct{}
+
+// Get gets a file.
+func (s *S) Get() error {
+	return ErrNotFound
+}
+`,
+			want: []chunk{
+				{text: "// Package store stores files.\npackage store\n\nimport (\n\t\"errors\"\n)", symbol: "package store", startLine: 1, endLine: 6},
+				{text: "// ErrNotFound is returned when a file is not found.\nvar ErrNotFound = errors.New(\"not found\")", symbol: "ErrNotFound", startLine: 8, endLine: 9},
+				{text: "const (\n\ta = 1\n\tb = 2\n)", symbol: "a, b", startLine: 11, endLine: 14},
+				{text: "// S is a store.\ntype S struct{}", symbol: "S", startLine: 16, endLine: 17},
+				{text: "// Get gets a file.\nfunc (s *S) Get() error {\n\treturn ErrNotFound\n}", symbol: "(*S).Get", startLine: 19, endLine: 22},
+			},
+		},
+		{
+			name:     "go with syntax errors",
+			fileType: ".go",
+			src: `package main
+
+func broken() {
+	return 1 +
+}
+
+// ok is ok.
+func ok() {}
+`,
+			want: []chunk{
+				{text: "package main", startLine: 1, endLine: 1},
+				{text: "func broken() {\n\treturn 1 +\n}", symbol: "broken", startLine: 3, endLine: 5},
+				{text: "// ok is ok.\nfunc ok() {}", symbol: "ok", startLine: 7, endLine: 8},
+			},
+		},
+		{
+			name:     "python",
+			fileType: ".py",
+			src: `"""Greeting module."""
+import os
+import sys
+
+# The default name.
+NAME = "world"
+
+
+@dataclass
+class Greeting:
+    """A greeting.
+
+# not a comment
+"""
+    name: str
+
+
+def main():
+    print({
+"a": 1,
+    })
+`,
+			want: []chunk{
+				{text: "\"\"\"Greeting module.\"\"\"\nimport os\nimport sys\n\n# The default name.\nNAME = \"world\"", startLine: 1, endLine: 6},
+				{text: "@dataclass\nclass Greeting:\n    \"\"\"A greeting.\n\n# not a comment\n\"\"\"\n    name: str", symbol: "Greeting", startLine: 9, endLine: 15},
+				{text: "def main():\n    print({\n\"a\": 1,\n    })", symbol: "main", startLine: 18, endLine: 21},
+			},
+		},
+		{
+			name:     "java",
+			fileType: ".java",
+			src: `import java.util.List;
+
+/**
+ * A greeter.
+ */
+@Component
+public class Greeter {
+    public String greet(String name) {
+        return "}" + name;
+    }
+}
+`,
+			want: []chunk{
+				{text: "import java.util.List;", startLine: 1, endLine: 1},
+				{text: "/**\n * A greeter.\n */\n@Component\npublic class Greeter {\n    public String greet(String name) {\n        return \"}\" + name;\n    }\n}", symbol: "Greeter", startLine: 3, endLine: 11},
+			},
+		},
+		{
+			name:     "c",
+			fileType: ".c",
+			src: `#include <stdio.h>
+
+// Prints a greeting.
+static void greet(const char *name)
+{
+    printf("Hello, %s\\n", name);
+}
+`,
+			want: []chunk{
+				{text: "#include <stdio.h>", startLine: 1, endLine: 1},
+				{text: "// Prints a greeting.\nstatic void greet(const char *name)\n{\n    printf(\"Hello, %s\\\\n\", name);\n}", symbol: "greet", startLine: 3, endLine: 7},
+			},
+		},
+		{
+			name:     "rust",
+			fileType: ".rs",
+			src: `#[derive(Debug)]
+struct Name<'a> {
+    value: &'a str,
+}
+
+fn first<'a>(x: &'a str) -> &'a str {
+    x
+}
+`,
+			want: []chunk{
+				{text: "#[derive(Debug)]\nstruct Name<'a> {\n    value: &'a str,\n}", symbol: "Name", startLine: 1, endLine: 4},
+				{text: "fn first<'a>(x: &'a str) -> &'a str {\n    x\n}", symbol: "first", startLine: 6, endLine: 8},
+			},
+		},
+	}
+
+	tok := newTestTokenizer(t)
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			splitter := textsplitter.NewRecursiveCharacter()
+			splitter.ChunkSize = 200
+			splitter.ChunkOverlap = 0
+			splitter.LenFunc = tok.countTokens
+			docs, err := splitCode(strings.NewReader(tc.src), tc.fileType, splitter)
+			assert.NoError(t, err)
+
+			var got []chunk
+			for _, d := range docs {
+				symbol, _ := d.Metadata[metadataKeySymbol].(string)
+				got = append(got, chunk{
+					text:      d.PageContent,
+					symbol:    symbol,
+					startLine: d.Metadata[metadataKeyStartLine].(int),
+					endLine:   d.Metadata[metadataKeyEndLine].(int),
+				})
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSplitCode_LargeDeclaration(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("package main\n\nfunc long() {\n")
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(&b, "\tfmt.Println(%d)\n\n", i)
+	}
+	b.WriteString("}\n")
+
+	tok := newTestTokenizer(t)
+	splitter := textsplitter.NewRecursiveCharacter()
+	splitter.ChunkSize = 30
+	splitter.ChunkOverlap = 0
+	splitter.LenFunc = tok.countTokens
+	docs, err := splitCode(strings.NewReader(b.String()), ".go", splitter)
+	assert.NoError(t, err)
+	assert.Greater(t, len(docs), 2)
+
+	lines := strings.Split(b.String(), "\n")
+	prevEnd := 1
+	for _, d := range docs[1:] {
+		assert.Equal(t, "long", d.Metadata[metadataKeySymbol])
+		start := d.Metadata[metadataKeyStartLine].(int)
+		end := d.Metadata[metadataKeyEndLine].(int)
+		assert.Greater(t, start, prevEnd)
+		// The line range matches the text of the chunk.
+		assert.Equal(t, strings.TrimSpace(strings.Join(lines[start-1:end], "\n")), d.PageContent)
+		prevEnd = end
+	}
+}
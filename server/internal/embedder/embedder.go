@@ -4,74 +4,184 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"os"
-	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/tmc/langchaingo/documentloaders"
 	"github.com/tmc/langchaingo/schema"
 	"github.com/tmc/langchaingo/textsplitter"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
-	charactersPerToken = 4
+	// metadataKeyFileName is the metadata key of the name of the file that a chunk comes from.
+	metadataKeyFileName = "file_name"
+	// metadataKeyPage is the metadata key of the 1-based number of the page that a chunk comes from.
+	// It is set by the PDF loader.
+	metadataKeyPage = "page"
 )
 
+// supportedFileTypes are the file types that splitFile can load.
+var supportedFileTypes = map[string]bool{
+	".pdf":      true,
+	".html":     true,
+	".txt":      true,
+	".md":       true,
+	".markdown": true,
+	".csv":      true,
+	".json":     true,
+	".jsonl":    true,
+	".docx":     true,
+	".pptx":     true,
+	".xlsx":     true,
+	".go":       true,
+	".py":       true,
+	".js":       true,
+	".ts":       true,
+	".java":     true,
+	".c":        true,
+	".h":        true,
+	".cpp":      true,
+	".rs":       true,
+}
+
+func isSupportedFileType(fileType string) bool {
+	return supportedFileTypes[strings.ToLower(fileType)]
+}
+
 // LLMClient is an interface to handle embedding requests.
 type LLMClient interface {
 	Embed(ctx context.Context, modelName, prompt string) ([]float32, error)
+	EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error)
 	PullModel(ctx context.Context, modelName string) error
 }
 
+// legacyLLMClient is implemented by LLM clients whose embeddings differ between the batch API and the legacy
+// API that older versions used.
+type legacyLLMClient interface {
+	EmbedLegacy(ctx context.Context, modelName, prompt string) ([]float32, error)
+}
+
+// Reranker is an interface to score the relevance of texts to a query with a cross-encoder model.
+type Reranker interface {
+	// Rerank returns the relevance scores of the texts in the same order as the texts. Higher is more relevant.
+	Rerank(ctx context.Context, modelName, query string, texts []string) ([]float32, error)
+}
+
 // s3Client is an interface for an S3 client.
 type s3Client interface {
 	Download(ctx context.Context, w io.WriterAt, key string) error
 }
 
 type vstoreClient interface {
-	InsertDocuments(ctx context.Context, collectionName string, files, texts []string, vectors [][]float32) error
+	InsertDocuments(
+		ctx context.Context,
+		collectionName string,
+		files, texts []string,
+		metadatas []map[string]any,
+		parentIDs []string,
+		chunkIndexes []int64,
+		attributes map[string]any,
+		vectors [][]float32,
+	) error
 	DeleteDocuments(ctx context.Context, collectionName, fileID string) error
-	Search(ctx context.Context, collectionName string, vectors []float32, numDocuments int) ([]string, error)
+	Search(
+		ctx context.Context,
+		collectionName string,
+		index vectordb.Index,
+		vectors []float32,
+		numDocuments int,
+		filter *vectordb.Filter,
+		withVectors bool,
+	) ([]vectordb.Document, error)
+	HybridSearch(
+		ctx context.Context,
+		collectionName string,
+		index vectordb.Index,
+		vectors []float32,
+		query string,
+		numDocuments int,
+		filter *vectordb.Filter,
+		fusion vectordb.Fusion,
+		withVectors bool,
+	) ([]vectordb.Document, error)
+	ListDocumentsByChunkRanges(ctx context.Context, collectionName string, ranges []vectordb.ChunkRange) ([]vectordb.Document, error)
 }
 
 // E is an embedder.
 type E struct {
-	llmClient    LLMClient
-	s3Client     s3Client
-	vstoreClient vstoreClient
-	log          logr.Logger
+	llmClient        LLMClient
+	s3Client         s3Client
+	vstoreClient     vstoreClient
+	parentChunkStore parentChunkStore
+	reranker         Reranker
+
+	batchSize             int
+	numParallelRequests   int
+	prependBreadcrumb     bool
+	retry                 config.RetryConfig
+	archive               config.ArchiveConfig
+	tokenizers            *tokenizers
+	rerankModel           string
+	rerankFetchMultiplier int
+
+	log logr.Logger
 }
 
-// New creates a new Embedder.
+// New creates a new Embedder. The reranker can be nil if reranking is not enabled.
 func New(
 	llmClient LLMClient,
 	s3Client s3Client,
 	vstoreClient vstoreClient,
+	parentChunkStore parentChunkStore,
+	reranker Reranker,
+	cfg config.EmbedderConfig,
 	log logr.Logger,
 ) *E {
 	return &E{
-		llmClient:    llmClient,
-		s3Client:     s3Client,
-		vstoreClient: vstoreClient,
-		log:          log.WithName("embed"),
+		llmClient:             llmClient,
+		s3Client:              s3Client,
+		vstoreClient:          vstoreClient,
+		parentChunkStore:      parentChunkStore,
+		reranker:              reranker,
+		batchSize:             cfg.BatchSize,
+		numParallelRequests:   cfg.NumParallelRequests,
+		prependBreadcrumb:     cfg.PrependBreadcrumb,
+		retry:                 cfg.Retry,
+		archive:               cfg.Archive,
+		tokenizers:            newTokenizers(cfg.Tokenizer.DefaultEncoding, cfg.Tokenizer.ModelEncodings),
+		rerankModel:           cfg.Reranker.Model,
+		rerankFetchMultiplier: cfg.Reranker.FetchMultiplier,
+		log:                   log.WithName("embed"),
 	}
 }
 
-// AddFile adds a file to the embedder.
+// AddFile adds a file to the embedder. It returns the chunking that was used to split the file.
+// The chunking is also returned together with a PartialArchiveError. The attributes of the file are stored
+// with every chunk so that searches can filter by them. The embeddings are normalized if the metric of the
+// collection requires it. legacyEmbedding specifies whether the chunks are embedded with the legacy API of
+// the LLM engine like the collections created by older versions.
 func (e *E) AddFile(
 	ctx context.Context,
 	collectionName,
-	modelName,
+	modelName string,
+	metric vectordb.MetricType,
+	legacyEmbedding bool,
 	fileID,
 	fileName,
 	filePath string,
-	chunkSizeTokens,
-	chunkOverlapTokens int64,
-) error {
+	cs ChunkingStrategy,
+	attributes map[string]any,
+) (*Chunking, error) {
 	e.log.Info("Downloading file", "from", filePath)
 	f, err := os.CreateTemp("/tmp", "rag-file-")
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	log := e.log.WithValues("file", f.Name())
@@ -82,39 +192,181 @@ func (e *E) AddFile(
 	}()
 
 	if err := e.s3Client.Download(ctx, f, filePath); err != nil {
-		return fmt.Errorf("download: %s", err)
+		return nil, fmt.Errorf("download: %s", err)
 	}
 	log.Info("Downloaded file")
 	if err := f.Close(); err != nil {
-		return err
+		return nil, err
 	}
 
-	docs, err := splitFile(logr.NewContext(ctx, log), f.Name(), filepath.Ext(fileName), chunkSizeTokens, chunkSizeTokens)
+	var docs []schema.Document
+	// partialErr is returned after the documents are added if some members of an archive could not be loaded.
+	var partialErr error
+	tok, err := e.tokenizers.get(modelName)
 	if err != nil {
-		return fmt.Errorf("split file: %s", err)
+		return nil, fmt.Errorf("get tokenizer: %s", err)
 	}
-	log.Info("Splitted file into chunks", "count", len(docs))
+	fileType, err := detectFileType(f.Name(), fileName)
+	if err != nil {
+		return nil, fmt.Errorf("detect file type: %w", err)
+	}
+	chunking := resolveChunking(fileType, cs)
+	log.Info("Detected file type", "type", fileType, "splitter", chunking.Splitter)
 
+	// Pull the model before splitting the file as the semantic splitter embeds sentences.
 	if err := e.llmClient.PullModel(ctx, modelName); err != nil {
-		return fmt.Errorf("pull model: %s", err)
+		return nil, fmt.Errorf("pull model: %s", err)
+	}
+	var semantic textsplitter.TextSplitter
+	if cs.Type == ChunkingStrategyTypeSemantic {
+		semantic = e.newSemanticSplitter(ctx, modelName, tok, cs)
+	}
+	// splitCS is the chunking strategy that splits the file. The file is split into parent chunks first
+	// for the parent-child chunking strategy.
+	splitCS := cs
+	if cs.Type == ChunkingStrategyTypeParentChild {
+		splitCS = parentChunkingStrategy(cs)
+	}
+
+	if isArchive(fileType) {
+		var memberErrs []MemberError
+		docs, memberErrs, err = e.splitArchive(logr.NewContext(ctx, log), f.Name(), fileType, tok, splitCS, semantic)
+		if err != nil {
+			return nil, fmt.Errorf("split archive: %s", err)
+		}
+		if len(memberErrs) > 0 {
+			partialErr = &PartialArchiveError{MemberErrors: memberErrs}
+			if len(docs) == 0 {
+				return nil, fmt.Errorf("split archive: %s", partialErr)
+			}
+		}
+	} else {
+		sc := resolveChunking(fileType, splitCS)
+		docs, err = splitFile(logr.NewContext(ctx, log), f.Name(), fileType, tok, sc.MaxChunkSizeTokens, sc.ChunkOverlapTokens, semantic)
+		if err != nil {
+			return nil, fmt.Errorf("split file: %w", err)
+		}
+	}
+	log.Info("Splitted file into chunks", "count", len(docs))
+
+	var parentIDs []string
+	if cs.Type == ChunkingStrategyTypeParentChild {
+		parents := docs
+		var parentIndexes []int
+		docs, parentIndexes, err = splitChildren(parents, tok, cs.MaxChunkSizeTokens)
+		if err != nil {
+			return nil, fmt.Errorf("split child chunks: %s", err)
+		}
+		log.Info("Splitted parent chunks into child chunks", "parents", len(parents), "children", len(docs))
+		if parentIDs, err = e.storeParentChunks(collectionName, fileID, parents, parentIndexes); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(docs) == 0 {
+		log.Info("No chunk to embed")
+		return &chunking, nil
 	}
 
-	var embeddings [][]float32
 	var texts []string
 	var files []string
-	for _, doc := range docs {
-		es, err := e.llmClient.Embed(ctx, modelName, doc.PageContent)
-		if err != nil {
-			return fmt.Errorf("llm embed: %s", err)
+	var metadatas []map[string]any
+	var chunkIndexes []int64
+	// inputs are the texts sent to the embedding model. They are different from texts only when breadcrumbs are prepended.
+	var inputs []string
+	for i, doc := range docs {
+		if doc.Metadata == nil {
+			doc.Metadata = map[string]any{}
 		}
-		embeddings = append(embeddings, es)
+		doc.Metadata[metadataKeyFileName] = fileName
 		texts = append(texts, doc.PageContent)
 		files = append(files, fileID)
+		metadatas = append(metadatas, doc.Metadata)
+		chunkIndexes = append(chunkIndexes, int64(i))
+		inputs = append(inputs, e.embeddingInput(doc))
+	}
+	embeddings, err := e.embedTexts(ctx, modelName, inputs, legacyEmbedding)
+	if err != nil {
+		return nil, fmt.Errorf("llm embed: %w", err)
+	}
+	log.Info("Created embeddings", "count", len(embeddings))
+	if metric.RequiresNormalizedVectors() {
+		for i, es := range embeddings {
+			embeddings[i] = normalizeVector(es)
+		}
+	}
+	if err := e.vstoreClient.InsertDocuments(ctx, collectionName, files, texts, metadatas, parentIDs, chunkIndexes, attributes, embeddings); err != nil {
+		return nil, err
+	}
+	return &chunking, partialErr
+}
+
+// embedTexts embeds the texts in batches. Batches are sent concurrently.
+func (e *E) embedTexts(ctx context.Context, modelName string, texts []string, legacy bool) ([][]float32, error) {
+	// Send batches concurrently. Each goroutine writes to its own range of embeddings.
+	embeddings := make([][]float32, len(texts))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(e.numParallelRequests)
+	for i := 0; i < len(texts); i += e.batchSize {
+		start, end := i, min(i+e.batchSize, len(texts))
+		g.Go(func() error {
+			es, err := e.embedBatchWithRetry(gctx, modelName, texts[start:end], legacy)
+			if err != nil {
+				return err
+			}
+			if len(es) != end-start {
+				return fmt.Errorf("unexpected number of embeddings: got %d, want %d", len(es), end-start)
+			}
+			copy(embeddings[start:end], es)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return embeddings, nil
+}
+
+// normalizeVector returns the vector scaled to the unit length. The zero vector is returned as it is.
+func normalizeVector(v []float32) []float32 {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return v
+	}
+	norm := math.Sqrt(sum)
+	nv := make([]float32, len(v))
+	for i, x := range v {
+		nv[i] = float32(float64(x) / norm)
 	}
-	return e.vstoreClient.InsertDocuments(ctx, collectionName, files, texts, embeddings)
+	return nv
 }
 
-func splitFile(ctx context.Context, fileName, fileType string, chunkSizeTokens, chunkOverlapTokens int64) ([]schema.Document, error) {
+// embeddingInput returns the text of the document that is embedded.
+func (e *E) embeddingInput(doc schema.Document) string {
+	if !e.prependBreadcrumb {
+		return doc.PageContent
+	}
+	breadcrumb, ok := doc.Metadata[metadataKeyBreadcrumb].(string)
+	if !ok || breadcrumb == "" {
+		return doc.PageContent
+	}
+	return breadcrumb + "\n\n" + doc.PageContent
+}
+
+// splitFile loads the file and splits it into chunks. The semantic splitter is used instead of
+// the recursive character splitter if it is not nil.
+func splitFile(
+	ctx context.Context,
+	fileName,
+	fileType string,
+	tok *tokenizer,
+	chunkSizeTokens,
+	chunkOverlapTokens int64,
+	semantic textsplitter.TextSplitter,
+) ([]schema.Document, error) {
 	logr.FromContextOrDiscard(ctx).Info("Splitting file into chunks")
 	file, err := os.Open(fileName)
 	if err != nil {
@@ -124,11 +376,16 @@ func splitFile(ctx context.Context, fileName, fileType string, chunkSizeTokens,
 		_ = file.Close()
 	}()
 
-	splitter := textsplitter.NewRecursiveCharacter()
-	splitter.ChunkSize = int(chunkSizeTokens) * charactersPerToken
-	splitter.ChunkOverlap = int(chunkOverlapTokens) * charactersPerToken
+	recursive := textsplitter.NewRecursiveCharacter()
+	recursive.ChunkSize = int(chunkSizeTokens)
+	recursive.ChunkOverlap = int(chunkOverlapTokens)
+	recursive.LenFunc = tok.countTokens
+	var splitter textsplitter.TextSplitter = recursive
+	if semantic != nil {
+		splitter = semantic
+	}
 
-	switch fileType {
+	switch strings.ToLower(fileType) {
 	case ".pdf":
 		finfo, err := file.Stat()
 		if err != nil {
@@ -136,35 +393,151 @@ func splitFile(ctx context.Context, fileName, fileType string, chunkSizeTokens,
 		}
 		return documentloaders.NewPDF(file, finfo.Size()).LoadAndSplit(ctx, splitter)
 	case ".html":
-		return documentloaders.NewHTML(file).LoadAndSplit(ctx, splitter)
+		docs, err := newHTMLLoader(file).Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return splitByHeadings(docs, splitter)
 	case ".txt":
 		return documentloaders.NewText(file).LoadAndSplit(ctx, splitter)
-	// TODO(guangrui): support more file types.
+	case ".md", ".markdown":
+		docs, err := documentloaders.NewText(file).Load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return splitByHeadings(docs, splitter)
+	case ".csv":
+		return documentloaders.NewCSV(file).LoadAndSplit(ctx, splitter)
+	case ".json":
+		return newJSONLoader(file).LoadAndSplit(ctx, splitter)
+	case ".jsonl":
+		return newJSONLLoader(file).LoadAndSplit(ctx, splitter)
+	case ".docx":
+		finfo, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		return newDOCXLoader(file, finfo.Size()).LoadAndSplit(ctx, splitter)
+	case ".pptx":
+		finfo, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		return newPPTXLoader(file, finfo.Size()).LoadAndSplit(ctx, splitter)
+	case ".xlsx":
+		finfo, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+		return newXLSXLoader(file, finfo.Size()).LoadAndSplit(ctx, splitter)
+	case ".go", ".py", ".js", ".ts", ".java", ".c", ".h", ".cpp", ".rs":
+		// Source code is always split at declarations, even with the semantic splitter.
+		return splitCode(file, strings.ToLower(fileType), recursive)
 	default:
-		return nil, fmt.Errorf("unexpected file type: fileType=%q", fileType)
+		return nil, fmt.Errorf("%w: fileType=%q", ErrUnsupportedFileType, fileType)
 	}
 }
 
 // DeleteFile deletes a file from the embedder.
 func (e *E) DeleteFile(ctx context.Context, collectionName, fileID string) error {
-	return e.vstoreClient.DeleteDocuments(ctx, collectionName, fileID)
+	if err := e.vstoreClient.DeleteDocuments(ctx, collectionName, fileID); err != nil {
+		return err
+	}
+	if err := e.parentChunkStore.DeleteParentChunksByFileID(collectionName, fileID); err != nil {
+		return fmt.Errorf("delete parent chunks: %s", err)
+	}
+	return nil
+}
+
+// SearchOptions are the options of Search.
+type SearchOptions struct {
+	// ContextWindow is the number of chunks before and after each matched chunk in the same file that are
+	// merged with the matched chunk.
+	ContextWindow int
+	// Filter limits the search to the files whose attributes match it if it is not nil.
+	Filter *vectordb.Filter
+	// Hybrid merges the results of a keyword search with the results of the vector search if it is not nil.
+	Hybrid *vectordb.Fusion
+	// Rerank reorders the results with the reranker. More results are fetched and only the most relevant
+	// ones are returned.
+	Rerank bool
+	// MMR diversifies the results by maximal marginal relevance if it is not nil.
+	MMR *MMR
+	// MaxResultsPerFile is the maximum number of results from the same file. There is no limit if it is 0.
+	MaxResultsPerFile int
+	// ScoreThreshold is the minimum score of the results between 0 and 1. Results with lower scores are dropped.
+	// It applies to the reranker score if the results are reranked, and to the score returned by the vector
+	// store otherwise. The score of reciprocal rank fusion reflects only the ranks, so the threshold is not
+	// meaningful for it.
+	ScoreThreshold float64
+	// LegacyEmbedding specifies whether the query is embedded with the legacy API of the LLM engine. It must
+	// match the API that the documents of the collection were embedded with.
+	LegacyEmbedding bool
 }
 
-// Search searches for the matched documents in the embedder for the given query.
-func (e *E) Search(ctx context.Context, collectionName, modelName, query string, numDocs int) ([]string, error) {
+// Search searches for the matched documents in the embedder for the given query. The index must be the one
+// that the collection is indexed with.
+func (e *E) Search(
+	ctx context.Context,
+	collectionName,
+	modelName string,
+	index vectordb.Index,
+	query string,
+	numDocs int,
+	opts SearchOptions,
+) ([]SearchResult, error) {
 	if err := e.llmClient.PullModel(ctx, modelName); err != nil {
 		return nil, fmt.Errorf("pull model: %s", err)
 	}
 
-	es, err := e.llmClient.Embed(ctx, modelName, query)
+	// numPassages is the number of passages that are built from the matched documents. More passages
+	// than requested are built as candidates for reranking.
+	numPassages := numDocs
+	if opts.Rerank {
+		if e.reranker == nil {
+			return nil, ErrRerankerNotConfigured
+		}
+		numPassages = numDocs * e.rerankFetchMultiplier
+	}
+
+	es, err := e.embedQuery(ctx, modelName, query, opts.LegacyEmbedding)
 	if err != nil {
 		return nil, fmt.Errorf("embed: %s", err)
 	}
+	if index.MetricType.RequiresNormalizedVectors() {
+		es = normalizeVector(es)
+	}
 
-	results, err := e.vstoreClient.Search(ctx, collectionName, es, numDocs)
+	numDocsToFetch := numPassages * searchFetchMultiplier
+	if opts.MMR != nil || opts.MaxResultsPerFile > 0 {
+		numDocsToFetch *= diversityFetchMultiplier
+	}
+	numDocsToFetch = min(numDocsToFetch, maxSearchDocuments)
+	withVectors := opts.MMR != nil
+	var docs []vectordb.Document
+	if opts.Hybrid != nil {
+		docs, err = e.vstoreClient.HybridSearch(ctx, collectionName, index, es, query, numDocsToFetch, opts.Filter, *opts.Hybrid, withVectors)
+	} else {
+		docs, err = e.vstoreClient.Search(ctx, collectionName, index, es, numDocsToFetch, opts.Filter, withVectors)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("vector search: %w", err)
+	}
+	if opts.MMR != nil {
+		docs = selectMMR(es, docs, numPassages*searchFetchMultiplier, opts.MMR.Lambda)
+	}
+	results, err := e.buildPassages(ctx, collectionName, docs, numPassages, opts.ContextWindow, opts.MaxResultsPerFile)
 	if err != nil {
-		return nil, fmt.Errorf("vector search: %s", err)
+		return nil, err
+	}
+	if opts.Rerank {
+		if results, err = e.rerank(ctx, query, results, numDocs); err != nil {
+			return nil, err
+		}
 	}
+	results = slices.DeleteFunc(results, func(r SearchResult) bool {
+		return float64(r.Score) < opts.ScoreThreshold
+	})
 	e.log.Info("search result", "query", query, "results", results)
 	return results, nil
 }
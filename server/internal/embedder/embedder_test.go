@@ -4,9 +4,18 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/stretchr/testify/assert"
 	"github.com/tmc/langchaingo/schema"
 )
@@ -55,20 +64,23 @@ func TestAddSearchDeleteFile(t *testing.T) {
 						2: {"line2"},
 					},
 				},
+				&noopParentChunkStore{},
+				nil, // reranker
+				newTestConfig(2),
 				testr.New(t),
 			)
 			ctx := context.Background()
-			err := e.AddFile(ctx, collectionName0, modelName, fileID, tc.fileName, tc.path, chunkSizeTokens, chunkOverlapTokens)
+			_, err := e.AddFile(ctx, collectionName0, modelName, vectordb.MetricTypeL2, false, fileID, tc.fileName, tc.path, newStaticChunkingStrategy(chunkSizeTokens, chunkOverlapTokens), nil)
 			if tc.wantErr {
 				assert.Error(t, err)
 				return
 			}
 			assert.NoError(t, err)
 
-			docs, err := e.Search(ctx, collectionName0, modelName, "line1", 1)
+			docs, err := e.Search(ctx, collectionName0, modelName, vectordb.Index{}, "line1", 1, SearchOptions{})
 			assert.NoError(t, err)
 			assert.Equal(t, 1, len(docs))
-			assert.Equal(t, "line1", docs[0])
+			assert.Equal(t, "line1", docs[0].Text)
 
 			err = e.DeleteFile(ctx, collectionName0, fileID)
 			assert.NoError(t, err)
@@ -76,6 +88,56 @@ func TestAddSearchDeleteFile(t *testing.T) {
 	}
 }
 
+func TestAddFile_Batch(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+	tcs := []struct {
+		name      string
+		batchSize int
+	}{
+		{
+			name:      "batch size 1",
+			batchSize: 1,
+		},
+		{
+			name:      "batch size 4",
+			batchSize: 4,
+		},
+		{
+			name:      "batch size larger than chunks",
+			batchSize: 1000,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			llm := &noopLLMClient{}
+			vs := &noopVStoreClient{collectionName: collectionName}
+			e := New(
+				llm,
+				&fileS3Client{path: "testdata/test.txt"},
+				vs,
+				&noopParentChunkStore{},
+				nil, // reranker
+				newTestConfig(tc.batchSize),
+				testr.New(t),
+			)
+			_, err := e.AddFile(context.Background(), collectionName, modelName, vectordb.MetricTypeL2, false, "file0", "test.txt", "key", newStaticChunkingStrategy(10, 2), nil)
+			assert.NoError(t, err)
+
+			numChunks := len(vs.texts)
+			assert.Greater(t, numChunks, 1)
+			assert.Len(t, vs.vectors, numChunks)
+			assert.Equal(t, (numChunks+tc.batchSize-1)/tc.batchSize, llm.numBatchCalls)
+			for _, size := range llm.batchSizes {
+				assert.LessOrEqual(t, size, tc.batchSize)
+			}
+		})
+	}
+}
+
 func TestSplitFile(t *testing.T) {
 	tcs := []struct {
 		name               string
@@ -95,7 +157,7 @@ func TestSplitFile(t *testing.T) {
 					PageContent: "Tokens can be",
 				},
 				{
-					PageContent: "can be thought of as",
+					PageContent: "be thought of",
 				},
 			},
 			wantErr: false,
@@ -105,7 +167,7 @@ func TestSplitFile(t *testing.T) {
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := context.Background()
-			got, err := splitFile(ctx, tc.path, ".txt", tc.chunkSizeTokens, tc.chunkOverlapTokens)
+			got, err := splitFile(ctx, tc.path, ".txt", newTestTokenizer(t), tc.chunkSizeTokens, tc.chunkOverlapTokens, nil)
 			if tc.wantErr {
 				assert.Error(t, err)
 				return
@@ -119,9 +181,162 @@ func TestSplitFile(t *testing.T) {
 
 }
 
+func newTestConfig(batchSize int) config.EmbedderConfig {
+	return config.EmbedderConfig{
+		BatchSize:           batchSize,
+		NumParallelRequests: 2,
+		Retry: config.RetryConfig{
+			MaxRetries:     3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+		},
+		Archive: config.ArchiveConfig{
+			MaxMembers:        10,
+			MaxTotalSizeBytes: 1024 * 1024,
+		},
+		Tokenizer: config.TokenizerConfig{
+			DefaultEncoding: "cl100k_base",
+		},
+	}
+}
+
+func newStaticChunkingStrategy(chunkSizeTokens, chunkOverlapTokens int64) ChunkingStrategy {
+	return ChunkingStrategy{
+		Type:               ChunkingStrategyTypeStatic,
+		MaxChunkSizeTokens: chunkSizeTokens,
+		ChunkOverlapTokens: chunkOverlapTokens,
+	}
+}
+
+func TestSplitFile_TokenLimit(t *testing.T) {
+	const (
+		chunkSizeTokens    = 10
+		chunkOverlapTokens = 2
+	)
+	tcs := []struct {
+		name     string
+		fileType string
+		content  string
+	}{
+		{
+			name:     "english",
+			fileType: ".txt",
+			content:  strings.Repeat("Vector stores make files available to the file search tool. ", 20),
+		},
+		{
+			name:     "japanese",
+			fileType: ".txt",
+			content:  strings.Repeat("ベクトルストアはファイル検索ツールでファイルを利用可能にします。", 20),
+		},
+		{
+			name:     "markdown",
+			fileType: ".md",
+			content:  "# 概要\n\n" + strings.Repeat("ベクトルストアはファイル検索ツールでファイルを利用可能にします。", 20),
+		},
+	}
+
+	tok := newTestTokenizer(t)
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file")
+			err := os.WriteFile(path, []byte(tc.content), 0644)
+			assert.NoError(t, err)
+
+			got, err := splitFile(context.Background(), path, tc.fileType, tok, chunkSizeTokens, chunkOverlapTokens, nil)
+			assert.NoError(t, err)
+			assert.Greater(t, len(got), 1)
+			for _, doc := range got {
+				assert.LessOrEqual(t, tok.countTokens(doc.PageContent), chunkSizeTokens, doc.PageContent)
+			}
+		})
+	}
+}
+
+func TestSplitFile_FileTypes(t *testing.T) {
+	tcs := []struct {
+		fileType string
+		want     []string
+	}{
+		{
+			fileType: ".md",
+			want: []string{
+				"# Vector stores\n\nVector stores make files available to the file search tool.",
+				"## Creating a vector store\n\nCreate a vector store and add files to it.",
+				"```go\nc.CreateVectorStore(ctx, req)\n```",
+				"## Deleting a vector store\n\nDeleting a vector store also deletes its files.",
+			},
+		},
+		{
+			fileType: ".html",
+			want: []string{
+				"# Getting started\n\nInstall the server and run it.",
+				"## Configuration\n\n- Set the port.\n- Set the model.",
+				"Key | Default\nport | 8080\n\n```\nserver --port 8080\n```",
+			},
+		},
+		{
+			fileType: ".csv",
+			want: []string{
+				"name: alice\nrole: engineer\nteam: storage",
+				"name: bob\nrole: manager\nteam: inference",
+			},
+		},
+		{
+			fileType: ".json",
+			want: []string{
+				"name: alice\nrole: engineer\nskills: [\"go\",\"python\"]",
+				"age: 42\nname: bob\nrole: manager",
+			},
+		},
+		{
+			fileType: ".jsonl",
+			want: []string{
+				"answer: A collection of embedded files.\nquestion: What is a vector store?",
+				"answer: A part of a file.\nquestion: What is a chunk?",
+			},
+		},
+		{
+			fileType: ".go",
+			want: []string{
+				"package main\n\nimport \"fmt\"",
+				"// Greeting is a greeting message.\ntype Greeting struct {\n\tName string\n}",
+				"func hello(g Greeting) {\n\tfmt.Println(\"Hello,\", g.Name)\n}",
+				"func main() {\n\thello(Greeting{Name: \"world\"})\n}",
+			},
+		},
+		{
+			fileType: ".py",
+			want: []string{
+				"class Greeting:\n    def __init__(self, name):\n        self.name = name",
+				"def hello(self):\n        print(\"Hello,\", self.name)",
+				"def main():\n    Greeting(\"world\").hello()",
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.fileType, func(t *testing.T) {
+			got, err := splitFile(context.Background(), "testdata/test"+tc.fileType, tc.fileType, newTestTokenizer(t), 20, 5, nil)
+			assert.NoError(t, err)
+			var texts []string
+			for _, doc := range got {
+				texts = append(texts, doc.PageContent)
+			}
+			assert.Equal(t, tc.want, texts)
+		})
+	}
+}
+
 type noopLLMClient struct {
 	// e is keyed by prompt
 	e map[string][]float32
+	// errs are returned by EmbedBatch in order before it succeeds.
+	errs []error
+
+	mu            sync.Mutex
+	numBatchCalls int
+	batchSizes    []int
+	prompts       []string
 }
 
 func (c *noopLLMClient) Embed(ctx context.Context, modelName, prompt string) ([]float32, error) {
@@ -132,6 +347,24 @@ func (c *noopLLMClient) Embed(ctx context.Context, modelName, prompt string) ([]
 	return e, nil
 }
 
+func (c *noopLLMClient) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.numBatchCalls++
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		return nil, err
+	}
+	c.batchSizes = append(c.batchSizes, len(prompts))
+	c.prompts = append(c.prompts, prompts...)
+	var es [][]float32
+	for range prompts {
+		es = append(es, []float32{0.1, 0.2})
+	}
+	return es, nil
+}
+
 func (c *noopLLMClient) PullModel(ctx context.Context, modelName string) error {
 	return nil
 }
@@ -144,20 +377,69 @@ func (n *noopS3Client) Download(ctx context.Context, w io.WriterAt, key string)
 	return nil
 }
 
+// fileS3Client is an S3 client that returns the content of a local file.
+type fileS3Client struct {
+	path string
+}
+
+// Download writes the content of the local file to w.
+func (c *fileS3Client) Download(ctx context.Context, w io.WriterAt, key string) error {
+	b, err := os.ReadFile(c.path)
+	if err != nil {
+		return err
+	}
+	_, err = w.WriteAt(b, 0)
+	return err
+}
+
 type noopVStoreClient struct {
 	collectionName string
 	docs           map[int][]string
+
+	mu           sync.Mutex
+	fileIDs      []string
+	texts        []string
+	metadatas    []map[string]any
+	parentIDs    []string
+	chunkIndexes []int64
+	attributes   []map[string]any
+	vectors      [][]float32
+	// filter is the filter of the last search.
+	filter *vectordb.Filter
+	// fusion is the fusion of the last hybrid search.
+	fusion *vectordb.Fusion
+	// numDocuments is the number of documents requested by the last search.
+	numDocuments int
+	// index is the index of the last search.
+	index vectordb.Index
+	// query is the query vector of the last search.
+	query []float32
 }
 
 func (c *noopVStoreClient) InsertDocuments(
 	ctx context.Context,
 	collectionName string,
 	fileIDs, texts []string,
+	metadatas []map[string]any,
+	parentIDs []string,
+	chunkIndexes []int64,
+	attributes map[string]any,
 	vectors [][]float32,
 ) error {
 	if collectionName != c.collectionName {
 		return fmt.Errorf("collection %s not found", collectionName)
 	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fileIDs = append(c.fileIDs, fileIDs...)
+	c.texts = append(c.texts, texts...)
+	c.parentIDs = append(c.parentIDs, parentIDs...)
+	c.chunkIndexes = append(c.chunkIndexes, chunkIndexes...)
+	c.metadatas = append(c.metadatas, metadatas...)
+	for range texts {
+		c.attributes = append(c.attributes, attributes)
+	}
+	c.vectors = append(c.vectors, vectors...)
 	return nil
 }
 
@@ -168,9 +450,324 @@ func (c *noopVStoreClient) DeleteDocuments(ctx context.Context, collectionName,
 	return nil
 }
 
-func (c *noopVStoreClient) Search(ctx context.Context, collectionName string, vectors []float32, numDocuments int) ([]string, error) {
+// Search returns the documents in docs for the first element of the vector. If docs is nil, the inserted
+// documents are returned in the order of insertion.
+func (c *noopVStoreClient) Search(
+	ctx context.Context,
+	collectionName string,
+	index vectordb.Index,
+	vectors []float32,
+	numDocuments int,
+	filter *vectordb.Filter,
+	withVectors bool,
+) ([]vectordb.Document, error) {
 	if collectionName != c.collectionName {
 		return nil, fmt.Errorf("collection %s not found", collectionName)
 	}
-	return c.docs[int(vectors[0])], nil
+	c.mu.Lock()
+	c.filter = filter
+	c.numDocuments = numDocuments
+	c.index = index
+	c.query = vectors
+	c.mu.Unlock()
+	var docs []vectordb.Document
+	if c.docs != nil {
+		for _, text := range c.docs[int(vectors[0])] {
+			docs = append(docs, vectordb.Document{Text: text})
+		}
+		return docs, nil
+	}
+	docs = c.documents()
+	if len(docs) > numDocuments {
+		docs = docs[:numDocuments]
+	}
+	for i := range docs {
+		docs[i].Score = 1 / float32(i+1)
+	}
+	if withVectors {
+		c.mu.Lock()
+		for i := range docs {
+			docs[i].Vector = c.vectors[docs[i].ID-1]
+		}
+		c.mu.Unlock()
+	}
+	return docs, nil
+}
+
+// HybridSearch records the fusion and returns the same documents as Search.
+func (c *noopVStoreClient) HybridSearch(
+	ctx context.Context,
+	collectionName string,
+	index vectordb.Index,
+	vectors []float32,
+	query string,
+	numDocuments int,
+	filter *vectordb.Filter,
+	fusion vectordb.Fusion,
+	withVectors bool,
+) ([]vectordb.Document, error) {
+	c.mu.Lock()
+	c.fusion = &fusion
+	c.mu.Unlock()
+	return c.Search(ctx, collectionName, index, vectors, numDocuments, filter, withVectors)
+}
+
+func (c *noopVStoreClient) ListDocumentsByChunkRanges(ctx context.Context, collectionName string, ranges []vectordb.ChunkRange) ([]vectordb.Document, error) {
+	if collectionName != c.collectionName {
+		return nil, fmt.Errorf("collection %s not found", collectionName)
+	}
+	var docs []vectordb.Document
+	for _, d := range c.documents() {
+		for _, r := range ranges {
+			if d.FileID == r.FileID && r.Start <= d.ChunkIndex && d.ChunkIndex <= r.End {
+				docs = append(docs, d)
+				break
+			}
+		}
+	}
+	return docs, nil
+}
+
+func (c *noopVStoreClient) documents() []vectordb.Document {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var docs []vectordb.Document
+	for i, text := range c.texts {
+		d := vectordb.Document{
+			ID:         int64(i + 1),
+			FileID:     c.fileIDs[i],
+			Text:       text,
+			ChunkIndex: c.chunkIndexes[i],
+		}
+		if i < len(c.metadatas) {
+			d.Metadata = c.metadatas[i]
+		}
+		if i < len(c.parentIDs) {
+			d.ParentID = c.parentIDs[i]
+		}
+		if i < len(c.attributes) {
+			d.Attributes = c.attributes[i]
+		}
+		docs = append(docs, d)
+	}
+	return docs
+}
+
+// noopReranker scores texts by the scores keyed by the texts. Other texts have a score of 0.
+type noopReranker struct {
+	scores map[string]float32
+
+	// modelName and texts are the arguments of the last call.
+	modelName string
+	texts     []string
+}
+
+func (r *noopReranker) Rerank(ctx context.Context, modelName, query string, texts []string) ([]float32, error) {
+	r.modelName = modelName
+	r.texts = texts
+	var scores []float32
+	for _, text := range texts {
+		scores = append(scores, r.scores[text])
+	}
+	return scores, nil
+}
+
+type noopParentChunkStore struct {
+	mu     sync.Mutex
+	chunks []*store.ParentChunk
+}
+
+func (s *noopParentChunkStore) CreateParentChunks(cs []*store.ParentChunk) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chunks = append(s.chunks, cs...)
+	return nil
+}
+
+func (s *noopParentChunkStore) ListParentChunksByChunkIDs(vectorStoreID string, chunkIDs []string) ([]*store.ParentChunk, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var cs []*store.ParentChunk
+	for _, c := range s.chunks {
+		if c.VectorStoreID == vectorStoreID && slices.Contains(chunkIDs, c.ChunkID) {
+			cs = append(cs, c)
+		}
+	}
+	return cs, nil
+}
+
+func (s *noopParentChunkStore) DeleteParentChunksByFileID(vectorStoreID, fileID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.chunks = slices.DeleteFunc(s.chunks, func(c *store.ParentChunk) bool {
+		return c.VectorStoreID == vectorStoreID && c.FileID == fileID
+	})
+	return nil
+}
+
+func TestAddFile_PrependBreadcrumb(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+
+	tcs := []struct {
+		name              string
+		prependBreadcrumb bool
+		wantPrompts       []string
+	}{
+		{
+			name: "not prepended",
+			wantPrompts: []string{
+				"# Vector stores\n\nVector stores make files available to the file search tool.",
+				"## Creating a vector store\n\nCreate a vector store and add files to it.",
+				"```go\nc.CreateVectorStore(ctx, req)\n```",
+				"## Deleting a vector store\n\nDeleting a vector store also deletes its files.",
+			},
+		},
+		{
+			name:              "prepended",
+			prependBreadcrumb: true,
+			wantPrompts: []string{
+				"Vector stores\n\n# Vector stores\n\nVector stores make files available to the file search tool.",
+				"Vector stores > Creating a vector store\n\n## Creating a vector store\n\nCreate a vector store and add files to it.",
+				"Vector stores > Creating a vector store\n\n```go\nc.CreateVectorStore(ctx, req)\n```",
+				"Vector stores > Deleting a vector store\n\n## Deleting a vector store\n\nDeleting a vector store also deletes its files.",
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			llm := &noopLLMClient{}
+			vs := &noopVStoreClient{collectionName: collectionName}
+			cfg := newTestConfig(10)
+			cfg.PrependBreadcrumb = tc.prependBreadcrumb
+			e := New(llm, &fileS3Client{path: "testdata/test.md"}, vs, &noopParentChunkStore{}, nil /* reranker */, cfg, testr.New(t))
+			_, err := e.AddFile(context.Background(), collectionName, modelName, vectordb.MetricTypeL2, false, "file0", "test.md", "key", newStaticChunkingStrategy(20, 5), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantPrompts, llm.prompts)
+
+			// The stored texts do not include the breadcrumbs.
+			assert.Equal(t, "```go\nc.CreateVectorStore(ctx, req)\n```", vs.texts[2])
+			var breadcrumbs []string
+			for _, m := range vs.metadatas {
+				breadcrumbs = append(breadcrumbs, m[metadataKeyBreadcrumb].(string))
+			}
+			want := []string{
+				"Vector stores",
+				"Vector stores > Creating a vector store",
+				"Vector stores > Creating a vector store",
+				"Vector stores > Deleting a vector store",
+			}
+			assert.Equal(t, want, breadcrumbs)
+		})
+	}
+}
+
+func TestAddSearchFile_Metric(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+
+	tcs := []struct {
+		name       string
+		metric     vectordb.MetricType
+		wantVector []float32
+		wantQuery  []float32
+	}{
+		{
+			name:       "l2",
+			metric:     vectordb.MetricTypeL2,
+			wantVector: []float32{0.1, 0.2},
+			wantQuery:  []float32{3, 4},
+		},
+		{
+			name:       "cosine",
+			metric:     vectordb.MetricTypeCosine,
+			wantVector: []float32{0.1, 0.2},
+			wantQuery:  []float32{3, 4},
+		},
+		{
+			name:       "ip",
+			metric:     vectordb.MetricTypeIP,
+			wantVector: []float32{0.4472136, 0.8944272},
+			wantQuery:  []float32{0.6, 0.8},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			vs := &noopVStoreClient{collectionName: collectionName}
+			llm := &noopLLMClient{
+				e: map[string][]float32{
+					"query": {3, 4},
+				},
+			}
+			e := New(llm, &fileS3Client{path: "testdata/test.md"}, vs, &noopParentChunkStore{}, nil /* reranker */, newTestConfig(10), testr.New(t))
+			ctx := context.Background()
+			_, err := e.AddFile(ctx, collectionName, modelName, tc.metric, false, "file0", "test.md", "key", newStaticChunkingStrategy(100, 10), nil)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, vs.vectors)
+			for _, v := range vs.vectors {
+				assert.InDeltaSlice(t, tc.wantVector, v, 1e-6)
+			}
+
+			_, err = e.Search(ctx, collectionName, modelName, vectordb.Index{MetricType: tc.metric}, "query", 1, SearchOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.metric, vs.index.MetricType)
+			assert.InDeltaSlice(t, tc.wantQuery, vs.query, 1e-6)
+		})
+	}
+}
+
+func TestSearch_ScoreThreshold(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+
+	vs := &noopVStoreClient{
+		collectionName: collectionName,
+		fileIDs:        []string{"file0", "file0", "file0", "file0"},
+		texts:          []string{"c0", "c1", "c2", "c3"},
+		chunkIndexes:   []int64{0, 1, 2, 3},
+	}
+	llm := &noopLLMClient{
+		e: map[string][]float32{
+			"query": {1, 0},
+		},
+	}
+	e := New(llm, &noopS3Client{}, vs, &noopParentChunkStore{}, nil /* reranker */, newTestConfig(10), testr.New(t))
+
+	tcs := []struct {
+		name           string
+		scoreThreshold float64
+		want           []string
+	}{
+		{
+			name: "no threshold",
+			want: []string{"c0", "c1", "c2"},
+		},
+		{
+			name:           "threshold",
+			scoreThreshold: 0.4,
+			want:           []string{"c0", "c1"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := e.Search(context.Background(), collectionName, modelName, vectordb.Index{}, "query", 3, SearchOptions{ScoreThreshold: tc.scoreThreshold})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, resultTexts(got))
+		})
+	}
+}
+
+func resultTexts(rs []SearchResult) []string {
+	var texts []string
+	for _, r := range rs {
+		texts = append(texts, r.Text)
+	}
+	return texts
 }
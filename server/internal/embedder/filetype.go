@@ -0,0 +1,147 @@
+package embedder
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	// sniffLen is the number of bytes used to detect the content type. This is the same as
+	// the number of bytes that http.DetectContentType considers.
+	sniffLen = 512
+
+	// tarMagicOffset is the offset of the "ustar" magic in a tar header.
+	tarMagicOffset = 257
+)
+
+// ErrUnsupportedFileType is returned when the type of a file is not supported.
+var ErrUnsupportedFileType = errors.New("unsupported file type")
+
+// textFileTypes are the file types of plain text files that are loaded differently
+// based on their extensions.
+var textFileTypes = map[string]bool{
+	".txt":      true,
+	".md":       true,
+	".markdown": true,
+	".csv":      true,
+	".json":     true,
+	".jsonl":    true,
+	".go":       true,
+	".py":       true,
+	".js":       true,
+	".ts":       true,
+	".java":     true,
+	".c":        true,
+	".h":        true,
+	".cpp":      true,
+	".rs":       true,
+}
+
+// detectFileType detects the type of the file from its content. The extension of fileName is
+// used to tell apart formats that cannot be detected from the content (e.g., Markdown and CSV)
+// and as a fallback when the content is not recognized.
+//
+// The returned type is one of the types accepted by splitFile or an archive type.
+// ErrUnsupportedFileType is returned if the file is not supported.
+func detectFileType(path, fileName string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	head = head[:n]
+	ext := fileTypeOf(fileName)
+
+	switch {
+	case bytes.HasPrefix(head, []byte("%PDF-")):
+		return ".pdf", nil
+	case bytes.HasPrefix(head, []byte("PK\x03\x04")), bytes.HasPrefix(head, []byte("PK\x05\x06")):
+		return detectZipFileType(f)
+	case bytes.HasPrefix(head, []byte("\x1f\x8b")):
+		if isTar(gunzipHead(f)) {
+			return ".tar.gz", nil
+		}
+		return "", fmt.Errorf("%w: gzip file that is not a tar archive", ErrUnsupportedFileType)
+	case isTar(head):
+		return ".tar", nil
+	}
+
+	contentType := http.DetectContentType(head)
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	switch {
+	case mediaType == "text/html":
+		// Structured text formats can start with HTML-like content (e.g., a comment in Markdown).
+		if textFileTypes[ext] && ext != ".txt" {
+			return ext, nil
+		}
+		return ".html", nil
+	case strings.HasPrefix(mediaType, "text/"):
+		if textFileTypes[ext] || ext == ".html" {
+			return ext, nil
+		}
+		// Files without an extension (e.g., README) or with an unknown extension are loaded as plain text.
+		return ".txt", nil
+	case mediaType == "application/octet-stream":
+		// The content is not recognized. Fall back to the extension.
+		if isSupportedFileType(ext) {
+			return ext, nil
+		}
+	}
+	return "", fmt.Errorf("%w: content type %q", ErrUnsupportedFileType, mediaType)
+}
+
+// detectZipFileType tells Office Open XML documents apart from other zip archives.
+func detectZipFileType(f *os.File) (string, error) {
+	finfo, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	z, err := zip.NewReader(f, finfo.Size())
+	if err != nil {
+		return "", fmt.Errorf("open zip: %s", err)
+	}
+	for _, zf := range z.File {
+		switch zf.Name {
+		case "word/document.xml":
+			return ".docx", nil
+		case "ppt/presentation.xml":
+			return ".pptx", nil
+		case "xl/workbook.xml":
+			return ".xlsx", nil
+		}
+	}
+	return ".zip", nil
+}
+
+// gunzipHead returns the beginning of the decompressed content of a gzip file.
+func gunzipHead(f *os.File) []byte {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil
+	}
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil
+	}
+	b := make([]byte, sniffLen)
+	n, _ := io.ReadFull(gr, b)
+	return b[:n]
+}
+
+func isTar(head []byte) bool {
+	return len(head) >= tarMagicOffset+5 && string(head[tarMagicOffset:tarMagicOffset+5]) == "ustar"
+}
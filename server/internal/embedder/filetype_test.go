@@ -0,0 +1,113 @@
+package embedder
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectFileType(t *testing.T) {
+	tcs := []struct {
+		name     string
+		path     string
+		content  string
+		fileName string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "pdf with uppercase extension",
+			content:  "%PDF-1.4\n",
+			fileName: "report.PDF",
+			want:     ".pdf",
+		},
+		{
+			name:     "pdf without extension",
+			content:  "%PDF-1.4\n",
+			fileName: "report",
+			want:     ".pdf",
+		},
+		{
+			name:     "text without extension",
+			content:  "Read me first.",
+			fileName: "README",
+			want:     ".txt",
+		},
+		{
+			name:     "html with txt extension",
+			content:  "<!DOCTYPE html><html><body>hello</body></html>",
+			fileName: "page.txt",
+			want:     ".html",
+		},
+		{
+			name:     "markdown starting with a comment",
+			content:  "<!-- comment -->\n# Title",
+			fileName: "doc.md",
+			want:     ".md",
+		},
+		{
+			name:     "csv",
+			path:     "testdata/test.csv",
+			fileName: "test.csv",
+			want:     ".csv",
+		},
+		{
+			name:     "docx with wrong extension",
+			path:     "testdata/test.docx",
+			fileName: "test.txt",
+			want:     ".docx",
+		},
+		{
+			name:     "xlsx",
+			path:     "testdata/test.xlsx",
+			fileName: "test.xlsx",
+			want:     ".xlsx",
+		},
+		{
+			name:     "zip",
+			path:     "testdata/test.zip",
+			fileName: "export",
+			want:     ".zip",
+		},
+		{
+			name:     "tar.gz",
+			path:     "testdata/test.tar.gz",
+			fileName: "export.tgz",
+			want:     ".tar.gz",
+		},
+		{
+			name:     "image",
+			content:  "\x89PNG\r\n\x1a\n\x00\x00\x00\x00",
+			fileName: "logo.txt",
+			wantErr:  true,
+		},
+		{
+			name:     "unknown binary",
+			content:  "\x00\x01\x02\x03",
+			fileName: "data.bin",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := tc.path
+			if path == "" {
+				path = filepath.Join(t.TempDir(), "file")
+				err := os.WriteFile(path, []byte(tc.content), 0644)
+				assert.NoError(t, err)
+			}
+			got, err := detectFileType(path, tc.fileName)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, ErrUnsupportedFileType))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package embedder
+
+import (
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+const (
+	// metadataKeyBreadcrumb is the metadata key of the headings of the section that a chunk belongs to
+	// (e.g., "Install > Helm > Values").
+	metadataKeyBreadcrumb = "breadcrumb"
+
+	breadcrumbSeparator = " > "
+)
+
+// atxHeadingRE matches an ATX heading such as "## Values" or "## Values ##".
+var atxHeadingRE = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+
+// section is a part of a Markdown document under a heading.
+type section struct {
+	// headings are the headings of the section and its ancestors, from the top level.
+	headings []string
+	text     string
+}
+
+type heading struct {
+	level int
+	text  string
+}
+
+// splitByHeadings splits Markdown documents into sections at headings and splits each section with splitter.
+// A chunk never spans two sections, and the breadcrumb of the section is recorded in the metadata of the chunk.
+// Sections that only have a heading are dropped as their headings are in the breadcrumbs of their subsections.
+func splitByHeadings(docs []schema.Document, splitter textsplitter.TextSplitter) ([]schema.Document, error) {
+	var result []schema.Document
+	for _, doc := range docs {
+		for _, s := range splitSections(doc.PageContent) {
+			chunks, err := splitter.SplitText(s.text)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range chunks {
+				metadata := maps.Clone(doc.Metadata)
+				if metadata == nil {
+					metadata = map[string]any{}
+				}
+				if len(s.headings) > 0 {
+					metadata[metadataKeyBreadcrumb] = strings.Join(s.headings, breadcrumbSeparator)
+				}
+				result = append(result, schema.Document{
+					PageContent: c,
+					Metadata:    metadata,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// splitSections splits Markdown text at ATX headings. Headings in fenced code blocks are ignored.
+// The heading line is kept at the beginning of the text of its section.
+func splitSections(text string) []section {
+	var (
+		sections []section
+		stack    []heading
+		lines    []string
+		hasBody  bool
+		// fence is the opening fence of the code block that the current line is in.
+		fence string
+	)
+	flush := func() {
+		if hasBody {
+			s := section{text: strings.TrimSpace(strings.Join(lines, "\n"))}
+			for _, h := range stack {
+				s.headings = append(s.headings, h.text)
+			}
+			sections = append(sections, s)
+		}
+		lines = nil
+		hasBody = false
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+		} else if f := codeFence(trimmed); f != "" {
+			fence = f
+		} else if m := atxHeadingRE.FindStringSubmatch(line); m != nil {
+			flush()
+			level := len(m[1])
+			for len(stack) > 0 && stack[len(stack)-1].level >= level {
+				stack = stack[:len(stack)-1]
+			}
+			stack = append(stack, heading{level: level, text: strings.TrimSpace(m[2])})
+			lines = append(lines, line)
+			continue
+		}
+		lines = append(lines, line)
+		if trimmed != "" {
+			hasBody = true
+		}
+	}
+	flush()
+	return sections
+}
+
+// codeFence returns the fence if the line opens a fenced code block.
+func codeFence(line string) string {
+	for _, f := range []string{"```", "~~~"} {
+		if strings.HasPrefix(line, f) {
+			return f
+		}
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+package embedder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitSections(t *testing.T) {
+	tcs := []struct {
+		name string
+		text string
+		want []section
+	}{
+		{
+			name: "nested headings",
+			text: "Intro\n\n# Install\n\n## Helm\n\n### Values\n\nSet it to true.\n\n## Docker\n\nRun it.\n\n# Usage ##\n\nCall the API.",
+			want: []section{
+				{text: "Intro"},
+				{headings: []string{"Install", "Helm", "Values"}, text: "### Values\n\nSet it to true."},
+				{headings: []string{"Install", "Docker"}, text: "## Docker\n\nRun it."},
+				{headings: []string{"Usage"}, text: "# Usage ##\n\nCall the API."},
+			},
+		},
+		{
+			name: "headings in code blocks",
+			text: "# Script\n\n```sh\n# not a heading\necho hello\n```\n\n~~~\n## not a heading\n~~~",
+			want: []section{
+				{
+					headings: []string{"Script"},
+					text:     "# Script\n\n```sh\n# not a heading\necho hello\n```\n\n~~~\n## not a heading\n~~~",
+				},
+			},
+		},
+		{
+			name: "not headings",
+			text: "#hashtag\n\n    # indented code",
+			want: []section{
+				{text: "#hashtag\n\n    # indented code"},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got := splitSections(tc.text)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/tmc/langchaingo/documentloaders"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// htmlLoader loads an HTML document as Markdown-style text so that it can be split at headings.
+//
+// Headings are rendered as "#" headings, list items as "- " lines, preformatted text as code blocks,
+// and table cells are joined with " | ". Scripts, styles and the document head are dropped.
+type htmlLoader struct {
+	r io.Reader
+}
+
+var _ documentloaders.Loader = htmlLoader{}
+
+func newHTMLLoader(r io.Reader) htmlLoader {
+	return htmlLoader{r: r}
+}
+
+// Load reads the HTML document and returns it as a single document.
+func (l htmlLoader) Load(_ context.Context) ([]schema.Document, error) {
+	n, err := html.Parse(l.r)
+	if err != nil {
+		return nil, fmt.Errorf("parse html: %s", err)
+	}
+	var c htmlConverter
+	c.convert(n)
+	return []schema.Document{
+		{
+			PageContent: c.b.String(),
+			Metadata:    map[string]any{},
+		},
+	}, nil
+}
+
+// LoadAndSplit reads the HTML document and splits it into chunks.
+func (l htmlLoader) LoadAndSplit(ctx context.Context, splitter textsplitter.TextSplitter) ([]schema.Document, error) {
+	docs, err := l.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return textsplitter.SplitDocuments(splitter, docs)
+}
+
+// htmlConverter converts HTML nodes to Markdown-style text. Whitespace in text is collapsed
+// as browsers do.
+type htmlConverter struct {
+	b strings.Builder
+	// brk is the line break written before the next text.
+	brk string
+	// space is true if a space is written before the next text.
+	space bool
+	// afterRaw is true if raw text was written last. Spaces are not written after raw text.
+	afterRaw bool
+}
+
+func (c *htmlConverter) convert(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		c.text(n.Data)
+		return
+	case html.ElementNode:
+	default:
+		c.convertChildren(n)
+		return
+	}
+
+	switch n.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Noscript, atom.Template:
+		return
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		c.breakLine("\n\n")
+		c.raw(strings.Repeat("#", int(n.Data[1]-'0')) + " ")
+		c.convertChildren(n)
+		c.breakLine("\n\n")
+	case atom.Pre:
+		c.breakLine("\n\n")
+		c.raw("```\n" + strings.Trim(textContent(n), "\n") + "\n```")
+		c.breakLine("\n\n")
+	case atom.Li:
+		c.breakLine("\n")
+		c.raw("- ")
+		c.convertChildren(n)
+		c.breakLine("\n")
+	case atom.Tr, atom.Dt, atom.Dd:
+		c.breakLine("\n")
+		c.convertChildren(n)
+		c.breakLine("\n")
+	case atom.Td, atom.Th:
+		if hasPrevElementSibling(n) {
+			c.raw(" | ")
+		}
+		c.convertChildren(n)
+	case atom.Br:
+		c.breakLine("\n")
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Nav, atom.Aside, atom.Main,
+		atom.Ul, atom.Ol, atom.Dl, atom.Table, atom.Blockquote, atom.Figure, atom.Hr, atom.Form:
+		c.breakLine("\n\n")
+		c.convertChildren(n)
+		c.breakLine("\n\n")
+	default:
+		c.convertChildren(n)
+	}
+}
+
+func (c *htmlConverter) convertChildren(n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.convert(child)
+	}
+}
+
+func (c *htmlConverter) text(s string) {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		if s != "" && !c.afterRaw {
+			c.space = true
+		}
+		return
+	}
+	if unicode.IsSpace(rune(s[0])) && !c.afterRaw {
+		c.space = true
+	}
+	c.flush()
+	c.b.WriteString(strings.Join(words, " "))
+	c.space = unicode.IsSpace(rune(s[len(s)-1]))
+	c.afterRaw = false
+}
+
+// raw writes s as is. No space is written between s and the next text.
+func (c *htmlConverter) raw(s string) {
+	c.space = false
+	c.flush()
+	c.b.WriteString(s)
+	c.afterRaw = true
+}
+
+// flush writes the pending line break or space. Nothing is written at the beginning of the text.
+func (c *htmlConverter) flush() {
+	switch {
+	case c.b.Len() == 0:
+	case c.brk != "":
+		c.b.WriteString(c.brk)
+	case c.space:
+		c.b.WriteByte(' ')
+	}
+	c.brk = ""
+	c.space = false
+}
+
+// breakLine requests a line break before the next text. A longer break that is already requested is kept.
+func (c *htmlConverter) breakLine(brk string) {
+	if len(brk) > len(c.brk) {
+		c.brk = brk
+	}
+}
+
+func textContent(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+func hasPrevElementSibling(n *html.Node) bool {
+	for s := n.PrevSibling; s != nil; s = s.PrevSibling {
+		if s.Type == html.ElementNode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package embedder
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadHTML(t *testing.T) {
+	f, err := os.Open("testdata/test.html")
+	assert.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+
+	docs, err := newHTMLLoader(f).Load(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, docs, 1)
+	want := "# Getting started\n\n" +
+		"Install the server and run it.\n\n" +
+		"## Configuration\n\n" +
+		"- Set the port.\n" +
+		"- Set the model.\n\n" +
+		"Key | Default\n" +
+		"port | 8080\n\n" +
+		"```\nserver --port 8080\n```"
+	assert.Equal(t, want, docs[0].PageContent)
+}
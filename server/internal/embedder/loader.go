@@ -0,0 +1,143 @@
+package embedder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/tmc/langchaingo/documentloaders"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+const (
+	// maxJSONLineBytes is the maximum size of a single record in a JSONL file.
+	maxJSONLineBytes = 16 * 1024 * 1024
+)
+
+// jsonLoader loads a JSON or JSONL document.
+//
+// A JSON array is loaded as one document per element, and a JSONL file is loaded as
+// one document per line. Other JSON values are loaded as a single document.
+// Objects are rendered as "key: value" lines so that every chunk carries its keys.
+type jsonLoader struct {
+	r     io.Reader
+	lines bool
+}
+
+var _ documentloaders.Loader = jsonLoader{}
+
+func newJSONLoader(r io.Reader) jsonLoader {
+	return jsonLoader{r: r}
+}
+
+func newJSONLLoader(r io.Reader) jsonLoader {
+	return jsonLoader{r: r, lines: true}
+}
+
+// Load reads the records from the reader and returns one document per record.
+func (l jsonLoader) Load(_ context.Context) ([]schema.Document, error) {
+	if l.lines {
+		return l.loadLines()
+	}
+
+	var v any
+	d := json.NewDecoder(l.r)
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decode json: %s", err)
+	}
+	records, ok := v.([]any)
+	if !ok {
+		records = []any{v}
+	}
+	var docs []schema.Document
+	for i, r := range records {
+		docs = append(docs, newRecordDocument(r, i+1))
+	}
+	return docs, nil
+}
+
+func (l jsonLoader) loadLines() ([]schema.Document, error) {
+	var docs []schema.Document
+	scanner := bufio.NewScanner(l.r)
+	scanner.Buffer(nil, maxJSONLineBytes)
+	var n int
+	for scanner.Scan() {
+		n++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var v any
+		d := json.NewDecoder(bytes.NewReader(line))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return nil, fmt.Errorf("decode json at line %d: %s", n, err)
+		}
+		docs = append(docs, newRecordDocument(v, n))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// LoadAndSplit loads the records and splits them with the given splitter.
+func (l jsonLoader) LoadAndSplit(ctx context.Context, splitter textsplitter.TextSplitter) ([]schema.Document, error) {
+	docs, err := l.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return textsplitter.SplitDocuments(splitter, docs)
+}
+
+func newRecordDocument(v any, row int) schema.Document {
+	return schema.Document{
+		PageContent: formatRecord(v),
+		Metadata:    map[string]any{"row": row},
+	}
+}
+
+// formatRecord renders an object as "key: value" lines sorted by key. Nested values
+// are rendered as compact JSON. Other values are rendered as they are.
+func formatRecord(v any) string {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return formatValue(v)
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("%s: %s", k, formatValue(obj[k])))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case nil:
+		return "null"
+	case map[string]any, []any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			// Values decoded from JSON can always be marshaled.
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,58 @@
+package embedder
+
+import (
+	"math"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+)
+
+// diversityFetchMultiplier is the number of candidates fetched for each document that is needed when the
+// results are diversified by MMR or limited per file.
+const diversityFetchMultiplier = 4
+
+// MMR configures maximal marginal relevance, which reorders the matched documents so that each document
+// is relevant to the query and different from the documents before it.
+type MMR struct {
+	// Lambda is the weight of the relevance between 0 and 1. The diversity has the weight of 1 - Lambda.
+	Lambda float64
+}
+
+// selectMMR selects at most n documents from the candidates in the order of maximal marginal relevance.
+// The relevance and the similarity between documents are measured by the cosine similarity of their
+// vectors. Documents without vectors have no similarity to anything.
+func selectMMR(query []float32, candidates []vectordb.Document, n int, lambda float64) []vectordb.Document {
+	relevances := make([]float64, len(candidates))
+	for i, c := range candidates {
+		relevances[i] = cosineSimilarity(query, c.Vector)
+	}
+	// maxSims are the maximum similarities between the candidates and the selected documents. They start
+	// from the minimum cosine similarity so that the first document is selected only by its relevance.
+	maxSims := make([]float64, len(candidates))
+	for i := range maxSims {
+		maxSims[i] = -1
+	}
+	selected := make([]bool, len(candidates))
+
+	var docs []vectordb.Document
+	for len(docs) < n && len(docs) < len(candidates) {
+		best := -1
+		bestScore := math.Inf(-1)
+		for i := range candidates {
+			if selected[i] {
+				continue
+			}
+			// Ties are broken by the original order, which is the order of relevance of the search.
+			if score := lambda*relevances[i] - (1-lambda)*maxSims[i]; score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		selected[best] = true
+		docs = append(docs, candidates[best])
+		for i, c := range candidates {
+			if !selected[i] {
+				maxSims[i] = math.Max(maxSims[i], cosineSimilarity(candidates[best].Vector, c.Vector))
+			}
+		}
+	}
+	return docs
+}
@@ -0,0 +1,69 @@
+package embedder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch_MMR(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+
+	vs := &noopVStoreClient{
+		collectionName: collectionName,
+		fileIDs:        []string{"file0", "file0", "file0", "file1"},
+		texts:          []string{"c0", "c0 copy", "c1", "d0"},
+		chunkIndexes:   []int64{0, 1, 2, 0},
+		// c0 copy is almost the same as c0, and d0 is less relevant but different.
+		vectors: [][]float32{{1, 0.1, 0}, {1, 0.11, 0}, {0.8, 0.6, 0}, {0.7, 0, 0.7}},
+	}
+	llm := &noopLLMClient{
+		e: map[string][]float32{
+			"query": {1, 0, 0},
+		},
+	}
+	e := New(llm, &noopS3Client{}, vs, &noopParentChunkStore{}, nil /* reranker */, newTestConfig(10), testr.New(t))
+	ctx := context.Background()
+
+	tcs := []struct {
+		name string
+		opts SearchOptions
+		want []string
+	}{
+		{
+			name: "relevance only",
+			opts: SearchOptions{MMR: &MMR{Lambda: 1}},
+			want: []string{"c0", "c0 copy", "c1"},
+		},
+		{
+			name: "diversity",
+			opts: SearchOptions{MMR: &MMR{Lambda: 0.3}},
+			want: []string{"c0", "d0", "c1"},
+		},
+		{
+			name: "max results per file",
+			opts: SearchOptions{MaxResultsPerFile: 2},
+			want: []string{"c0", "c0 copy", "d0"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := e.Search(ctx, collectionName, modelName, vectordb.Index{}, "query", 3, tc.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, resultTexts(got))
+			// More candidates are fetched to diversify the results.
+			assert.Equal(t, 3*searchFetchMultiplier*diversityFetchMultiplier, vs.numDocuments)
+		})
+	}
+
+	// The number of candidates is capped by the limits of the vector databases.
+	_, err := e.Search(ctx, collectionName, modelName, vectordb.Index{}, "query", 100, SearchOptions{MaxResultsPerFile: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, maxSearchDocuments, vs.numDocuments)
+}
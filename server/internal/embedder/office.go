@@ -0,0 +1,570 @@
+package embedder
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/tmc/langchaingo/documentloaders"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+const (
+	// xlsxRowsPerDocument is the number of rows of a sheet put into a single document.
+	// The header row of the sheet is repeated in every document.
+	xlsxRowsPerDocument = 50
+	// xlsxMaxPaddedColumns is the number of columns up to which empty cells are padded so that values stay in
+	// their columns. Cells in later columns are appended without padding so that a cell reference far to the
+	// right does not allocate a huge row.
+	xlsxMaxPaddedColumns = 1024
+
+	relTypeSuffixSlide      = "/slide"
+	relTypeSuffixNotesSlide = "/notesSlide"
+
+	// metadataKeySlide is the metadata key of the 1-based number of the slide that a chunk comes from.
+	metadataKeySlide = "slide"
+)
+
+// officeLoader loads an Office Open XML document (.docx, .pptx or .xlsx).
+type officeLoader struct {
+	r    io.ReaderAt
+	size int64
+	load func(z *zip.Reader) ([]schema.Document, error)
+}
+
+var _ documentloaders.Loader = officeLoader{}
+
+// newDOCXLoader creates a loader that returns a single document with the paragraphs and the tables.
+func newDOCXLoader(r io.ReaderAt, size int64) officeLoader {
+	return officeLoader{r: r, size: size, load: loadDOCX}
+}
+
+// newPPTXLoader creates a loader that returns one document per slide. Speaker notes
+// are appended to the text of the slide.
+func newPPTXLoader(r io.ReaderAt, size int64) officeLoader {
+	return officeLoader{r: r, size: size, load: loadPPTX}
+}
+
+// newXLSXLoader creates a loader that returns one document per block of rows in each sheet.
+func newXLSXLoader(r io.ReaderAt, size int64) officeLoader {
+	return officeLoader{r: r, size: size, load: loadXLSX}
+}
+
+// Load extracts the text of the document.
+func (l officeLoader) Load(_ context.Context) ([]schema.Document, error) {
+	z, err := zip.NewReader(l.r, l.size)
+	if err != nil {
+		return nil, fmt.Errorf("open zip: %s", err)
+	}
+	return l.load(z)
+}
+
+// LoadAndSplit extracts the text of the document and splits it with the given splitter.
+func (l officeLoader) LoadAndSplit(ctx context.Context, splitter textsplitter.TextSplitter) ([]schema.Document, error) {
+	docs, err := l.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return textsplitter.SplitDocuments(splitter, docs)
+}
+
+func loadDOCX(z *zip.Reader) ([]schema.Document, error) {
+	d, err := openXML(z, "word/document.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = d.Close()
+	}()
+
+	// Tables can be nested in table cells, so keep a stack of the tables being read.
+	type table struct {
+		rows  []string
+		row   []string
+		cell  []string
+		depth int
+	}
+	var (
+		blocks []string
+		tables []*table
+		para   strings.Builder
+		inText bool
+	)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse document: %s", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if isTablePart(t.Name.Local) && len(tables) == 0 {
+				return nil, fmt.Errorf("invalid document: %s element outside a table", t.Name.Local)
+			}
+			switch t.Name.Local {
+			case "p":
+				para.Reset()
+			case "t":
+				inText = true
+			case "tab":
+				para.WriteString("\t")
+			case "br", "cr":
+				para.WriteString("\n")
+			case "tbl":
+				tables = append(tables, &table{})
+			case "tr":
+				tables[len(tables)-1].row = nil
+			case "tc":
+				tables[len(tables)-1].cell = nil
+			}
+		case xml.CharData:
+			if inText {
+				para.Write(t)
+			}
+		case xml.EndElement:
+			if (isTablePart(t.Name.Local) || t.Name.Local == "tbl") && len(tables) == 0 {
+				return nil, fmt.Errorf("invalid document: %s element outside a table", t.Name.Local)
+			}
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				text := strings.TrimSpace(para.String())
+				if text == "" {
+					continue
+				}
+				if len(tables) > 0 {
+					tbl := tables[len(tables)-1]
+					tbl.cell = append(tbl.cell, text)
+				} else {
+					blocks = append(blocks, text)
+				}
+			case "tc":
+				tbl := tables[len(tables)-1]
+				tbl.row = append(tbl.row, strings.Join(tbl.cell, " "))
+			case "tr":
+				tbl := tables[len(tables)-1]
+				tbl.rows = append(tbl.rows, strings.Join(tbl.row, " | "))
+			case "tbl":
+				tbl := tables[len(tables)-1]
+				tables = tables[:len(tables)-1]
+				text := strings.Join(tbl.rows, "\n")
+				if len(tables) > 0 {
+					parent := tables[len(tables)-1]
+					parent.cell = append(parent.cell, text)
+				} else {
+					blocks = append(blocks, text)
+				}
+			}
+		}
+	}
+
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+	return []schema.Document{
+		{
+			PageContent: strings.Join(blocks, "\n\n"),
+			Metadata:    map[string]any{},
+		},
+	}, nil
+}
+
+func loadPPTX(z *zip.Reader) ([]schema.Document, error) {
+	slidePaths, err := pptxSlidePaths(z)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []schema.Document
+	for i, p := range slidePaths {
+		paras, err := pptxParagraphs(z, p)
+		if err != nil {
+			return nil, err
+		}
+
+		rels, err := readRelationships(z, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, rel := range rels {
+			if !strings.HasSuffix(rel.Type, relTypeSuffixNotesSlide) {
+				continue
+			}
+			notes, err := pptxParagraphs(z, resolveTarget(p, rel.Target))
+			if err != nil {
+				return nil, err
+			}
+			if len(notes) > 0 {
+				paras = append(paras, "Notes:\n"+strings.Join(notes, "\n"))
+			}
+		}
+
+		if len(paras) == 0 {
+			continue
+		}
+		docs = append(docs, schema.Document{
+			PageContent: strings.Join(paras, "\n"),
+			Metadata:    map[string]any{metadataKeySlide: i + 1},
+		})
+	}
+	return docs, nil
+}
+
+// pptxSlidePaths returns the paths of the slides in the order of the presentation.
+func pptxSlidePaths(z *zip.Reader) ([]string, error) {
+	const presentationPath = "ppt/presentation.xml"
+	var pres struct {
+		SlideIDs []struct {
+			RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sldIdLst>sldId"`
+	}
+	if err := decodeXML(z, presentationPath, &pres); err != nil {
+		return nil, err
+	}
+	rels, err := readRelationships(z, presentationPath)
+	if err != nil {
+		return nil, err
+	}
+	targets := map[string]string{}
+	for _, rel := range rels {
+		if strings.HasSuffix(rel.Type, relTypeSuffixSlide) {
+			targets[rel.ID] = resolveTarget(presentationPath, rel.Target)
+		}
+	}
+
+	var paths []string
+	for _, s := range pres.SlideIDs {
+		p, ok := targets[s.RID]
+		if !ok {
+			return nil, fmt.Errorf("slide relationship %q not found", s.RID)
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// pptxParagraphs returns the non-empty paragraphs of a slide or a notes slide.
+// Slide number placeholders are skipped.
+func pptxParagraphs(z *zip.Reader, name string) ([]string, error) {
+	d, err := openXML(z, name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = d.Close()
+	}()
+
+	var (
+		paras     []string
+		shape     []string
+		skipShape bool
+		para      strings.Builder
+		inText    bool
+	)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %s", name, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "sp":
+				shape = nil
+				skipShape = false
+			case "ph":
+				for _, a := range t.Attr {
+					if a.Name.Local == "type" && a.Value == "sldNum" {
+						skipShape = true
+					}
+				}
+			case "p":
+				para.Reset()
+			case "t":
+				inText = true
+			case "br":
+				para.WriteString("\n")
+			}
+		case xml.CharData:
+			if inText {
+				para.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				if text := strings.TrimSpace(para.String()); text != "" {
+					shape = append(shape, text)
+				}
+			case "sp", "graphicFrame":
+				if !skipShape {
+					paras = append(paras, shape...)
+				}
+				shape = nil
+				skipShape = false
+			}
+		}
+	}
+	return paras, nil
+}
+
+func loadXLSX(z *zip.Reader) ([]schema.Document, error) {
+	const workbookPath = "xl/workbook.xml"
+	var wb struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := decodeXML(z, workbookPath, &wb); err != nil {
+		return nil, err
+	}
+	rels, err := readRelationships(z, workbookPath)
+	if err != nil {
+		return nil, err
+	}
+	targets := map[string]string{}
+	for _, rel := range rels {
+		targets[rel.ID] = resolveTarget(workbookPath, rel.Target)
+	}
+
+	sharedStrings, err := xlsxSharedStrings(z)
+	if err != nil {
+		return nil, err
+	}
+
+	var docs []schema.Document
+	for _, sheet := range wb.Sheets {
+		p, ok := targets[sheet.RID]
+		if !ok {
+			return nil, fmt.Errorf("sheet relationship %q not found", sheet.RID)
+		}
+		rows, err := xlsxRows(z, p, sharedStrings)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		// Treat the first row as the header and repeat it in every document.
+		header := rows[0]
+		if len(rows) == 1 {
+			docs = append(docs, schema.Document{
+				PageContent: "Sheet: " + sheet.Name + "\n" + header.text,
+				Metadata:    map[string]any{"sheet": sheet.Name, "row": header.num},
+			})
+			continue
+		}
+		for i := 1; i < len(rows); i += xlsxRowsPerDocument {
+			end := min(i+xlsxRowsPerDocument, len(rows))
+			lines := []string{"Sheet: " + sheet.Name, header.text}
+			for _, r := range rows[i:end] {
+				lines = append(lines, r.text)
+			}
+			docs = append(docs, schema.Document{
+				PageContent: strings.Join(lines, "\n"),
+				Metadata:    map[string]any{"sheet": sheet.Name, "row": rows[i].num},
+			})
+		}
+	}
+	return docs, nil
+}
+
+type xlsxRow struct {
+	num  int
+	text string
+}
+
+// xlsxRows returns the non-empty rows of a sheet. Cells are separated by " | ".
+func xlsxRows(z *zip.Reader, name string, sharedStrings []string) ([]xlsxRow, error) {
+	var ws struct {
+		Rows []struct {
+			Num   int `xml:"r,attr"`
+			Cells []struct {
+				Ref    string `xml:"r,attr"`
+				Type   string `xml:"t,attr"`
+				Value  string `xml:"v"`
+				Inline struct {
+					Text string `xml:"t"`
+					Runs []struct {
+						Text string `xml:"t"`
+					} `xml:"r"`
+				} `xml:"is"`
+			} `xml:"c"`
+		} `xml:"sheetData>row"`
+	}
+	if err := decodeXML(z, name, &ws); err != nil {
+		return nil, err
+	}
+
+	var rows []xlsxRow
+	for ri, r := range ws.Rows {
+		var cells []string
+		for ci, c := range r.Cells {
+			var v string
+			switch c.Type {
+			case "s":
+				idx, err := strconv.Atoi(c.Value)
+				if err != nil || idx < 0 || idx >= len(sharedStrings) {
+					return nil, fmt.Errorf("invalid shared string index %q in %s", c.Value, name)
+				}
+				v = sharedStrings[idx]
+			case "inlineStr":
+				v = c.Inline.Text
+				for _, run := range c.Inline.Runs {
+					v += run.Text
+				}
+			case "b":
+				v = "FALSE"
+				if c.Value == "1" {
+					v = "TRUE"
+				}
+			default:
+				v = c.Value
+			}
+
+			// Empty cells are omitted from the sheet. Pad the row so that values stay in their columns.
+			col := ci
+			if c.Ref != "" {
+				col = columnIndex(c.Ref)
+			}
+			for len(cells) < min(col, xlsxMaxPaddedColumns) {
+				cells = append(cells, "")
+			}
+			cells = append(cells, strings.TrimSpace(v))
+		}
+
+		text := strings.TrimRight(strings.Join(cells, " | "), " |")
+		if text == "" {
+			continue
+		}
+		num := r.Num
+		if num == 0 {
+			num = ri + 1
+		}
+		rows = append(rows, xlsxRow{num: num, text: text})
+	}
+	return rows, nil
+}
+
+// xlsxSharedStrings returns the shared string table of a workbook.
+func xlsxSharedStrings(z *zip.Reader) ([]string, error) {
+	const name = "xl/sharedStrings.xml"
+	if _, err := z.Open(name); err != nil {
+		// The shared string table is optional.
+		return nil, nil
+	}
+	var sst struct {
+		Items []struct {
+			Text string `xml:"t"`
+			Runs []struct {
+				Text string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"si"`
+	}
+	if err := decodeXML(z, name, &sst); err != nil {
+		return nil, err
+	}
+	var strs []string
+	for _, item := range sst.Items {
+		s := item.Text
+		for _, run := range item.Runs {
+			s += run.Text
+		}
+		strs = append(strs, s)
+	}
+	return strs, nil
+}
+
+// isTablePart returns true if the element of a Word document is a row or a cell, which must be in a table.
+func isTablePart(name string) bool {
+	return name == "tr" || name == "tc"
+}
+
+// columnIndex converts the column letters of a cell reference (e.g., "AB12") to a zero-based index.
+func columnIndex(ref string) int {
+	var n int
+	for _, c := range ref {
+		if c < 'A' || c > 'Z' {
+			break
+		}
+		n = n*26 + int(c-'A'+1)
+		if n > xlsxMaxPaddedColumns {
+			// The exact index is not needed past the padded columns. Stop before it overflows.
+			break
+		}
+	}
+	return n - 1
+}
+
+type relationship struct {
+	ID     string `xml:"Id,attr"`
+	Type   string `xml:"Type,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+// readRelationships reads the relationships of the given part. It returns nil if the part has no relationships.
+func readRelationships(z *zip.Reader, name string) ([]relationship, error) {
+	relsPath := path.Join(path.Dir(name), "_rels", path.Base(name)+".rels")
+	if _, err := z.Open(relsPath); err != nil {
+		return nil, nil
+	}
+	var rels struct {
+		Relationships []relationship `xml:"Relationship"`
+	}
+	if err := decodeXML(z, relsPath, &rels); err != nil {
+		return nil, err
+	}
+	return rels.Relationships, nil
+}
+
+// resolveTarget resolves the target of a relationship relative to the part that owns the relationship.
+func resolveTarget(name, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+	return path.Join(path.Dir(name), target)
+}
+
+type xmlDecoder struct {
+	*xml.Decoder
+	io.Closer
+}
+
+func openXML(z *zip.Reader, name string) (*xmlDecoder, error) {
+	f, err := z.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %s", name, err)
+	}
+	return &xmlDecoder{Decoder: xml.NewDecoder(f), Closer: f}, nil
+}
+
+func decodeXML(z *zip.Reader, name string, v any) error {
+	d, err := openXML(z, name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = d.Close()
+	}()
+	if err := d.Decode(v); err != nil {
+		return fmt.Errorf("parse %s: %s", name, err)
+	}
+	return nil
+}
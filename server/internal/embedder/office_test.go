@@ -0,0 +1,131 @@
+package embedder
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmc/langchaingo/schema"
+)
+
+func TestLoadOfficeFile(t *testing.T) {
+	tcs := []struct {
+		fileType  string
+		newLoader func(io.ReaderAt, int64) officeLoader
+		want      []schema.Document
+	}{
+		{
+			fileType:  ".docx",
+			newLoader: newDOCXLoader,
+			want: []schema.Document{
+				{
+					PageContent: "Quarterly report\n\nRevenue grew in every region.\n\nRegion | Revenue\nTokyo | 120\n\nThank you.",
+					Metadata:    map[string]any{},
+				},
+			},
+		},
+		{
+			fileType:  ".pptx",
+			newLoader: newPPTXLoader,
+			want: []schema.Document{
+				{
+					PageContent: "Welcome\nAgenda for today",
+					Metadata:    map[string]any{"slide": 1},
+				},
+				{
+					PageContent: "Roadmap\nShip vector search\nNotes:\nMention the beta date.",
+					Metadata:    map[string]any{"slide": 2},
+				},
+			},
+		},
+		{
+			fileType:  ".xlsx",
+			newLoader: newXLSXLoader,
+			want: []schema.Document{
+				{
+					PageContent: "Sheet: Employees\nname | role | active\nalice | engineer | TRUE\nbob |  | FALSE",
+					Metadata:    map[string]any{"sheet": "Employees", "row": 2},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.fileType, func(t *testing.T) {
+			f, err := os.Open("testdata/test" + tc.fileType)
+			assert.NoError(t, err)
+			defer func() {
+				_ = f.Close()
+			}()
+			finfo, err := f.Stat()
+			assert.NoError(t, err)
+
+			got, err := tc.newLoader(f, finfo.Size()).Load(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+
+			_, err = splitFile(context.Background(), f.Name(), tc.fileType, newTestTokenizer(t), 10, 2, nil)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLoadDOCX_Malformed(t *testing.T) {
+	tcs := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "row outside a table",
+			body: `<w:tr><w:tc><w:p><w:r><w:t>a</w:t></w:r></w:p></w:tc></w:tr>`,
+		},
+		{
+			name: "end of a cell outside a table",
+			body: `<w:p><w:r><w:t>a</w:t></w:r></w:p></w:tc>`,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			z := newTestZip(t, map[string]string{
+				"word/document.xml": `<w:document xmlns:w="w"><w:body>` + tc.body + `</w:body></w:document>`,
+			})
+			_, err := loadDOCX(z)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestXLSXRows_FarColumn(t *testing.T) {
+	z := newTestZip(t, map[string]string{
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData><row r="1">` +
+			`<c r="A1" t="inlineStr"><is><t>a</t></is></c>` +
+			`<c r="XFD1048576" t="inlineStr"><is><t>b</t></is></c>` +
+			`<c r="ZZZZZZZZZZZZZZZ1" t="inlineStr"><is><t>c</t></is></c>` +
+			`</row></sheetData></worksheet>`,
+	})
+	rows, err := xlsxRows(z, "xl/worksheets/sheet1.xml", nil)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	// Empty cells are padded only up to xlsxMaxPaddedColumns.
+	assert.Equal(t, "a"+strings.Repeat(" | ", xlsxMaxPaddedColumns-1)+" | b | c", rows[0].text)
+}
+
+func newTestZip(t *testing.T, files map[string]string) *zip.Reader {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, body := range files {
+		f, err := w.Create(name)
+		assert.NoError(t, err)
+		_, err = f.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	return z
+}
@@ -0,0 +1,85 @@
+package embedder
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/tmc/langchaingo/schema"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+// parentChunkStore stores parent chunks. Parent chunks are not stored in the vector database as they can
+// be longer than the texts that the vector database can store.
+type parentChunkStore interface {
+	CreateParentChunks(cs []*store.ParentChunk) error
+	ListParentChunksByChunkIDs(vectorStoreID string, chunkIDs []string) ([]*store.ParentChunk, error)
+	DeleteParentChunksByFileID(vectorStoreID, fileID string) error
+}
+
+// parentChunkingStrategy returns the chunking strategy that splits a file into parent chunks.
+func parentChunkingStrategy(cs ChunkingStrategy) ChunkingStrategy {
+	return ChunkingStrategy{
+		Type:               ChunkingStrategyTypeStatic,
+		MaxChunkSizeTokens: cs.MaxParentChunkSizeTokens,
+	}
+}
+
+// splitChildren splits parent chunks into child chunks. The child chunks inherit the metadata of their
+// parent chunks. The index of the parent chunk of each child chunk is returned as well.
+func splitChildren(parents []schema.Document, tok *tokenizer, maxChunkSizeTokens int64) ([]schema.Document, []int, error) {
+	splitter := textsplitter.NewRecursiveCharacter()
+	splitter.ChunkSize = int(maxChunkSizeTokens)
+	splitter.ChunkOverlap = 0
+	splitter.LenFunc = tok.countTokens
+
+	var (
+		children []schema.Document
+		indexes  []int
+	)
+	for i, p := range parents {
+		texts, err := splitter.SplitText(p.PageContent)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, t := range texts {
+			children = append(children, schema.Document{
+				PageContent: t,
+				Metadata:    maps.Clone(p.Metadata),
+			})
+			indexes = append(indexes, i)
+		}
+	}
+	return children, indexes, nil
+}
+
+// parentChunkID returns the ID of the i-th parent chunk of the file. The ID is unique in a vector store.
+func parentChunkID(fileID string, i int) string {
+	return fmt.Sprintf("%s-%d", fileID, i)
+}
+
+// storeParentChunks stores the parent chunks of the file and returns the IDs of the parent chunks of
+// the child chunks. Parent chunks stored by an earlier attempt to add the file are replaced.
+func (e *E) storeParentChunks(collectionName, fileID string, parents []schema.Document, parentIndexes []int) ([]string, error) {
+	if err := e.parentChunkStore.DeleteParentChunksByFileID(collectionName, fileID); err != nil {
+		return nil, fmt.Errorf("delete parent chunks: %s", err)
+	}
+	var cs []*store.ParentChunk
+	for i, p := range parents {
+		cs = append(cs, &store.ParentChunk{
+			VectorStoreID: collectionName,
+			FileID:        fileID,
+			ChunkID:       parentChunkID(fileID, i),
+			Text:          p.PageContent,
+		})
+	}
+	if err := e.parentChunkStore.CreateParentChunks(cs); err != nil {
+		return nil, fmt.Errorf("create parent chunks: %s", err)
+	}
+
+	var ids []string
+	for _, i := range parentIndexes {
+		ids = append(ids, parentChunkID(fileID, i))
+	}
+	return ids, nil
+}
@@ -0,0 +1,83 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddSearchDeleteFile_ParentChild(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+		fileID         = "file0"
+	)
+
+	vs := &noopVStoreClient{collectionName: collectionName}
+	ps := &noopParentChunkStore{}
+	llm := &noopLLMClient{
+		e: map[string][]float32{
+			"vector store": {1, 0},
+		},
+	}
+	e := New(llm, &fileS3Client{path: "testdata/test.md"}, vs, ps, nil /* reranker */, newTestConfig(10), testr.New(t))
+	ctx := context.Background()
+	cs := ChunkingStrategy{
+		Type:                     ChunkingStrategyTypeParentChild,
+		MaxChunkSizeTokens:       10,
+		MaxParentChunkSizeTokens: 100,
+	}
+	attributes := map[string]any{"lang": "en"}
+	chunking, err := e.AddFile(ctx, collectionName, modelName, vectordb.MetricTypeL2, false, fileID, "test.md", "key", cs, attributes)
+	assert.NoError(t, err)
+	assert.Equal(t, &Chunking{Splitter: splitterMarkdownHeadings, MaxChunkSizeTokens: 10}, chunking)
+
+	// Each section is a parent chunk.
+	wantParents := []string{
+		"# Vector stores\n\nVector stores make files available to the file search tool.",
+		"## Creating a vector store\n\nCreate a vector store and add files to it.\n\n```go\nc.CreateVectorStore(ctx, req)\n```",
+		"## Deleting a vector store\n\nDeleting a vector store also deletes its files.",
+	}
+	var parents []string
+	for i, c := range ps.chunks {
+		assert.Equal(t, fmt.Sprintf("file0-%d", i), c.ChunkID)
+		parents = append(parents, c.Text)
+	}
+	assert.Equal(t, wantParents, parents)
+
+	// Child chunks are embedded and reference their parent chunks.
+	assert.Greater(t, len(vs.texts), len(wantParents))
+	assert.Len(t, vs.parentIDs, len(vs.texts))
+	for i, text := range vs.texts {
+		var p int
+		_, err := fmt.Sscanf(vs.parentIDs[i], "file0-%d", &p)
+		assert.NoError(t, err)
+		assert.Contains(t, wantParents[p], text)
+	}
+
+	// The child chunks of the same parent chunk are merged.
+	filter := &vectordb.Filter{Type: vectordb.FilterTypeEq, Key: "lang", Value: "en"}
+	got, err := e.Search(ctx, collectionName, modelName, vectordb.Index{}, "vector store", 2, SearchOptions{Filter: filter})
+	assert.NoError(t, err)
+	assert.Equal(t, wantParents[:2], resultTexts(got))
+	assert.Equal(t, "test.md", got[0].FileName)
+	assert.Equal(t, attributes, got[0].Attributes)
+	assert.Equal(t, filter, vs.filter)
+	assert.Nil(t, vs.fusion)
+
+	// Hybrid search merges the child chunks in the same way.
+	fusion := vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: 0.7}
+	got, err = e.Search(ctx, collectionName, modelName, vectordb.Index{}, "vector store", 2, SearchOptions{Hybrid: &fusion})
+	assert.NoError(t, err)
+	assert.Equal(t, wantParents[:2], resultTexts(got))
+	assert.Equal(t, float32(1), got[0].Score)
+	assert.Equal(t, &fusion, vs.fusion)
+
+	err = e.DeleteFile(ctx, collectionName, fileID)
+	assert.NoError(t, err)
+	assert.Empty(t, ps.chunks)
+}
@@ -0,0 +1,249 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+)
+
+// searchFetchMultiplier is the number of documents fetched from the vector database for each requested
+// passage. Child chunks of the same parent chunk, and chunks in the context window of a more similar chunk,
+// are merged into one passage, so more documents are fetched than requested.
+const searchFetchMultiplier = 4
+
+// maxSearchDocuments is the maximum number of documents fetched from the vector database by a search. It is
+// within the limits of all the vector databases: an HNSW index of pgvector returns at most 1000 candidates
+// (the maximum of hnsw.ef_search), and the maximum topK of Milvus is 16384.
+const maxSearchDocuments = 1000
+
+// SearchResult is a passage that is found by Search.
+type SearchResult struct {
+	// ChunkID is the ID of the matched chunk.
+	ChunkID  string
+	FileID   string
+	FileName string
+	// Distance is the distance between the query and the matched chunk. Smaller is more similar.
+	// It is 0 for hybrid search.
+	Distance float32
+	// Score is the relevance of the passage between 0 and 1. Higher is more relevant. It is the score of
+	// the reranker if the results are reranked, and the score of the matched chunk otherwise.
+	Score float32
+	// ChunkIndex is the position of the matched chunk in the file. It is -1 if it is unknown.
+	ChunkIndex int64
+	// PageNumber is the 1-based number of the page or the slide that the matched chunk comes from.
+	// It is 0 if the file does not have pages.
+	PageNumber int
+	// Text is the text of the passage. It is the text of the parent chunk for a child chunk, and includes
+	// the neighboring chunks if the context window is not zero.
+	Text string
+	// Attributes are the attributes of the file.
+	Attributes map[string]any
+}
+
+// passage is a text returned by Search.
+type passage struct {
+	text string
+	// hit is the matched document. It is expanded with the documents in chunks if chunks is not nil.
+	hit    vectordb.Document
+	chunks *vectordb.ChunkRange
+}
+
+func (p *passage) toSearchResult() SearchResult {
+	r := SearchResult{
+		ChunkID:    strconv.FormatInt(p.hit.ID, 10),
+		FileID:     p.hit.FileID,
+		Distance:   p.hit.Distance,
+		Score:      p.hit.Score,
+		ChunkIndex: p.hit.ChunkIndex,
+		Text:       p.text,
+		Attributes: p.hit.Attributes,
+	}
+	if name, ok := p.hit.Metadata[metadataKeyFileName].(string); ok {
+		r.FileName = name
+	}
+	for _, key := range []string{metadataKeyPage, metadataKeySlide} {
+		// Numbers in metadata are decoded from JSON as float64.
+		if n, ok := p.hit.Metadata[key].(float64); ok {
+			r.PageNumber = int(n)
+			break
+		}
+	}
+	return r
+}
+
+// buildPassages builds at most numPassages passages from the matched documents in the order of similarity.
+//
+// A child chunk is replaced with its parent chunk, and a parent chunk is returned only once. Other chunks
+// are merged with the contextWindow chunks before and after them in the same file. A chunk that is
+// in the context window of a more similar chunk is not returned by itself. At most maxPerFile passages
+// are built from the same file unless it is 0.
+func (e *E) buildPassages(
+	ctx context.Context,
+	collectionName string,
+	docs []vectordb.Document,
+	numPassages,
+	contextWindow,
+	maxPerFile int,
+) ([]SearchResult, error) {
+	parents, err := e.listParentChunks(collectionName, docs)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		passages    []passage
+		ranges      []vectordb.ChunkRange
+		seenParents = map[string]bool{}
+		fileCounts  = map[string]int{}
+	)
+	add := func(p passage) {
+		passages = append(passages, p)
+		fileCounts[p.hit.FileID]++
+	}
+	for _, d := range docs {
+		if len(passages) == numPassages {
+			break
+		}
+		if maxPerFile > 0 && fileCounts[d.FileID] == maxPerFile {
+			continue
+		}
+		if text, ok := parents[d.ParentID]; ok {
+			if seenParents[d.ParentID] {
+				continue
+			}
+			seenParents[d.ParentID] = true
+			add(passage{text: text, hit: d})
+			continue
+		}
+		// Child chunks whose parent chunks are not found are not expanded as their neighbors are
+		// parts of other parent chunks.
+		if contextWindow == 0 || d.ChunkIndex < 0 || d.ParentID != "" {
+			add(passage{text: d.Text, hit: d})
+			continue
+		}
+		if inChunkRanges(ranges, d) {
+			continue
+		}
+		r := vectordb.ChunkRange{
+			FileID: d.FileID,
+			Start:  max(0, d.ChunkIndex-int64(contextWindow)),
+			End:    d.ChunkIndex + int64(contextWindow),
+		}
+		ranges = append(ranges, r)
+		add(passage{text: d.Text, hit: d, chunks: &r})
+	}
+	if len(ranges) == 0 {
+		return searchResults(passages), nil
+	}
+
+	neighbors, err := e.vstoreClient.ListDocumentsByChunkRanges(ctx, collectionName, ranges)
+	if err != nil {
+		return nil, fmt.Errorf("list neighboring chunks: %s", err)
+	}
+	for i, p := range passages {
+		if p.chunks == nil {
+			continue
+		}
+		passages[i].text = mergeChunks(p.hit, *p.chunks, neighbors)
+	}
+	return searchResults(passages), nil
+}
+
+// listParentChunks returns the texts of the parent chunks of the documents keyed by their IDs.
+func (e *E) listParentChunks(collectionName string, docs []vectordb.Document) (map[string]string, error) {
+	var ids []string
+	for _, d := range docs {
+		if d.ParentID != "" {
+			ids = append(ids, d.ParentID)
+		}
+	}
+	parents := map[string]string{}
+	if len(ids) == 0 {
+		return parents, nil
+	}
+	cs, err := e.parentChunkStore.ListParentChunksByChunkIDs(collectionName, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list parent chunks: %s", err)
+	}
+	for _, c := range cs {
+		parents[c.ChunkID] = c.Text
+	}
+	return parents, nil
+}
+
+func inChunkRanges(ranges []vectordb.ChunkRange, d vectordb.Document) bool {
+	for _, r := range ranges {
+		if r.FileID == d.FileID && r.Start <= d.ChunkIndex && d.ChunkIndex <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+func searchResults(passages []passage) []SearchResult {
+	var rs []SearchResult
+	for _, p := range passages {
+		rs = append(rs, p.toSearchResult())
+	}
+	return rs
+}
+
+// mergeChunks merges the chunks in the range into one text in the order of their positions. The hit is
+// used if no chunk is found in the range.
+func mergeChunks(hit vectordb.Document, r vectordb.ChunkRange, docs []vectordb.Document) string {
+	var chunks []vectordb.Document
+	for _, d := range docs {
+		if d.FileID == r.FileID && r.Start <= d.ChunkIndex && d.ChunkIndex <= r.End {
+			chunks = append(chunks, d)
+		}
+	}
+	if len(chunks) == 0 {
+		return hit.Text
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].ChunkIndex < chunks[j].ChunkIndex
+	})
+
+	text := chunks[0].Text
+	for i := 1; i < len(chunks); i++ {
+		if chunks[i].ChunkIndex == chunks[i-1].ChunkIndex {
+			continue
+		}
+		next := chunks[i].Text
+		if n := overlapLen(text, next); n > 0 {
+			text += next[n:]
+			continue
+		}
+		text += "\n\n" + next
+	}
+	return text
+}
+
+// overlapLen returns the length of the longest suffix of a that is also a prefix of b. Chunks split
+// with overlaps repeat the end of the previous chunk. Only overlaps that start and end at word
+// boundaries are considered so that a word that happens to be repeated is not merged.
+func overlapLen(a, b string) int {
+	for n := min(len(a), len(b)); n > 0; n-- {
+		if !strings.HasSuffix(a, b[:n]) {
+			continue
+		}
+		if n < len(a) {
+			if r, _ := utf8.DecodeLastRuneInString(a[:len(a)-n]); !unicode.IsSpace(r) {
+				continue
+			}
+		}
+		if n < len(b) {
+			if r, _ := utf8.DecodeRuneInString(b[n:]); !unicode.IsSpace(r) {
+				continue
+			}
+		}
+		return n
+	}
+	return 0
+}
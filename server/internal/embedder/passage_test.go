@@ -0,0 +1,146 @@
+package embedder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPassages(t *testing.T) {
+	const collectionName = "collection0"
+
+	vs := &noopVStoreClient{
+		collectionName: collectionName,
+		fileIDs:        []string{"file0", "file0", "file0", "file0", "file0", "file1", "file1", "file2", "file2"},
+		texts:          []string{"c0", "c1", "c2", "c3", "c4", "a b c", "c d e", "child0", "child1"},
+		chunkIndexes:   []int64{0, 1, 2, 3, 4, 0, 1, 0, 1},
+		parentIDs:      []string{"", "", "", "", "", "", "", "file2-0", "file2-0"},
+	}
+	ps := &noopParentChunkStore{
+		chunks: []*store.ParentChunk{
+			{VectorStoreID: collectionName, FileID: "file2", ChunkID: "file2-0", Text: "parent"},
+		},
+	}
+	e := New(&noopLLMClient{}, &noopS3Client{}, vs, ps, nil /* reranker */, newTestConfig(10), testr.New(t))
+	docs := vs.documents()
+
+	tcs := []struct {
+		name          string
+		hits          []int
+		numPassages   int
+		contextWindow int
+		maxPerFile    int
+		want          []string
+		wantChunkIDs  []string
+	}{
+		{
+			name:         "no context window",
+			hits:         []int{2, 3, 6},
+			numPassages:  10,
+			want:         []string{"c2", "c3", "c d e"},
+			wantChunkIDs: []string{"3", "4", "7"},
+		},
+		{
+			name:          "context window",
+			hits:          []int{2, 3, 6},
+			numPassages:   10,
+			contextWindow: 1,
+			// c3 is in the context window of c2, and the overlapping "c" of the chunks of file1 is merged.
+			want:         []string{"c1\n\nc2\n\nc3", "a b c d e"},
+			wantChunkIDs: []string{"3", "7"},
+		},
+		{
+			name:          "context window at the beginning of a file",
+			hits:          []int{0},
+			numPassages:   10,
+			contextWindow: 2,
+			want:          []string{"c0\n\nc1\n\nc2"},
+			wantChunkIDs:  []string{"1"},
+		},
+		{
+			name:          "parent chunks",
+			hits:          []int{7, 0, 8},
+			numPassages:   10,
+			contextWindow: 1,
+			want:          []string{"parent", "c0\n\nc1"},
+			wantChunkIDs:  []string{"8", "1"},
+		},
+		{
+			name:         "number of passages",
+			hits:         []int{0, 1, 2},
+			numPassages:  2,
+			want:         []string{"c0", "c1"},
+			wantChunkIDs: []string{"1", "2"},
+		},
+		{
+			name:         "max passages per file",
+			hits:         []int{0, 1, 5, 2, 6},
+			numPassages:  10,
+			maxPerFile:   1,
+			want:         []string{"c0", "a b c"},
+			wantChunkIDs: []string{"1", "6"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var hits []vectordb.Document
+			for _, i := range tc.hits {
+				hits = append(hits, docs[i])
+			}
+			got, err := e.buildPassages(context.Background(), collectionName, hits, tc.numPassages, tc.contextWindow, tc.maxPerFile)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, resultTexts(got))
+			var chunkIDs []string
+			for _, r := range got {
+				chunkIDs = append(chunkIDs, r.ChunkID)
+			}
+			assert.Equal(t, tc.wantChunkIDs, chunkIDs)
+		})
+	}
+}
+
+func TestSearchResult(t *testing.T) {
+	p := passage{
+		text: "text",
+		hit: vectordb.Document{
+			ID:         42,
+			FileID:     "file0",
+			Distance:   0.5,
+			ChunkIndex: 3,
+			Metadata: map[string]any{
+				metadataKeyFileName: "test.pdf",
+				metadataKeyPage:     float64(2),
+			},
+		},
+	}
+	want := SearchResult{
+		ChunkID:    "42",
+		FileID:     "file0",
+		FileName:   "test.pdf",
+		Distance:   0.5,
+		ChunkIndex: 3,
+		PageNumber: 2,
+		Text:       "text",
+	}
+	assert.Equal(t, want, p.toSearchResult())
+}
+
+func TestOverlapLen(t *testing.T) {
+	tcs := []struct {
+		a, b string
+		want int
+	}{
+		{a: "the quick brown fox", b: "brown fox jumps", want: len("brown fox")},
+		{a: "the quick brown fox", b: "the quick brown fox", want: len("the quick brown fox")},
+		{a: "foo bar", b: "baz qux", want: 0},
+		// "ox" is not a word in the first text.
+		{a: "the fox", b: "ox jumps", want: 0},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.want, overlapLen(tc.a, tc.b), "%q %q", tc.a, tc.b)
+	}
+}
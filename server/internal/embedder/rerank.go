@@ -0,0 +1,41 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrRerankerNotConfigured is returned when reranking is requested but no reranker is configured.
+var ErrRerankerNotConfigured = errors.New("reranker is not configured")
+
+// rerank scores the results with the reranker and returns at most numResults results in the order of
+// the scores. The score of each result is replaced with the score of the reranker.
+func (e *E) rerank(ctx context.Context, query string, results []SearchResult, numResults int) ([]SearchResult, error) {
+	if len(results) == 0 {
+		return results, nil
+	}
+	var texts []string
+	for _, r := range results {
+		texts = append(texts, r.Text)
+	}
+	scores, err := e.reranker.Rerank(ctx, e.rerankModel, query, texts)
+	if err != nil {
+		return nil, fmt.Errorf("rerank: %s", err)
+	}
+	if len(scores) != len(results) {
+		return nil, fmt.Errorf("unexpected number of rerank scores: got %d, want %d", len(scores), len(results))
+	}
+	for i := range results {
+		results[i].Score = scores[i]
+	}
+	// Keep the original order for the results with the same score.
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	if len(results) > numResults {
+		results = results[:numResults]
+	}
+	return results, nil
+}
@@ -0,0 +1,91 @@
+package embedder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch_Rerank(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+
+	tcs := []struct {
+		name           string
+		reranker       *noopReranker
+		scoreThreshold float64
+		want           []string
+		wantTexts      []string
+		wantErr        error
+	}{
+		{
+			name: "reranked",
+			reranker: &noopReranker{
+				scores: map[string]float32{"c1": 0.5, "c3": 0.9},
+			},
+			want: []string{"c3", "c1"},
+			// Two candidates are reranked for each requested result.
+			wantTexts: []string{"c0", "c1", "c2", "c3"},
+		},
+		{
+			name: "score threshold",
+			reranker: &noopReranker{
+				scores: map[string]float32{"c1": 0.5, "c3": 0.9},
+			},
+			scoreThreshold: 0.6,
+			want:           []string{"c3"},
+			wantTexts:      []string{"c0", "c1", "c2", "c3"},
+		},
+		{
+			name:    "not configured",
+			wantErr: ErrRerankerNotConfigured,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			vs := &noopVStoreClient{
+				collectionName: collectionName,
+				fileIDs:        []string{"file0", "file0", "file0", "file0", "file0"},
+				texts:          []string{"c0", "c1", "c2", "c3", "c4"},
+				chunkIndexes:   []int64{0, 1, 2, 3, 4},
+			}
+			llm := &noopLLMClient{
+				e: map[string][]float32{
+					"query": {1, 0},
+				},
+			}
+			cfg := newTestConfig(10)
+			cfg.Reranker = config.RerankerConfig{
+				Enable:          true,
+				Engine:          config.RerankerEngineTEI,
+				Addr:            "reranker:8080",
+				Model:           "reranker0",
+				FetchMultiplier: 2,
+			}
+			var r Reranker
+			if tc.reranker != nil {
+				r = tc.reranker
+			}
+			e := New(llm, &noopS3Client{}, vs, &noopParentChunkStore{}, r, cfg, testr.New(t))
+			got, err := e.Search(context.Background(), collectionName, modelName, vectordb.Index{}, "query", 2, SearchOptions{
+				Rerank:         true,
+				ScoreThreshold: tc.scoreThreshold,
+			})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, resultTexts(got))
+			assert.Equal(t, float32(0.9), got[0].Score)
+			assert.Equal(t, tc.wantTexts, tc.reranker.texts)
+			assert.Equal(t, "reranker0", tc.reranker.modelName)
+		})
+	}
+}
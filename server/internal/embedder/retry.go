@@ -0,0 +1,110 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ollama/ollama/api"
+	"github.com/sashabaranov/go-openai"
+)
+
+// ErrRateLimitExceeded is returned when the LLM engine keeps rejecting requests
+// due to rate limiting or overload after all retries.
+var ErrRateLimitExceeded = errors.New("embedder: rate limit exceeded")
+
+// embedBatch embeds the prompts with EmbedBatch, or one at a time with the legacy API if legacy is true and
+// the LLM client has one.
+func (e *E) embedBatch(ctx context.Context, modelName string, prompts []string, legacy bool) ([][]float32, error) {
+	lc, ok := e.llmClient.(legacyLLMClient)
+	if !legacy || !ok {
+		return e.llmClient.EmbedBatch(ctx, modelName, prompts)
+	}
+	es := make([][]float32, len(prompts))
+	for i, p := range prompts {
+		var err error
+		if es[i], err = lc.EmbedLegacy(ctx, modelName, p); err != nil {
+			return nil, err
+		}
+	}
+	return es, nil
+}
+
+// embedQuery embeds the query in the same way as the documents.
+func (e *E) embedQuery(ctx context.Context, modelName, query string, legacy bool) ([]float32, error) {
+	if lc, ok := e.llmClient.(legacyLLMClient); legacy && ok {
+		return lc.EmbedLegacy(ctx, modelName, query)
+	}
+	return e.llmClient.Embed(ctx, modelName, query)
+}
+
+// embedBatchWithRetry embeds the prompts and retries transient failures with exponential backoff and jitter.
+func (e *E) embedBatchWithRetry(ctx context.Context, modelName string, prompts []string, legacy bool) ([][]float32, error) {
+	backoff := e.retry.InitialBackoff
+	for i := 0; ; i++ {
+		es, err := e.embedBatch(ctx, modelName, prompts, legacy)
+		if err == nil {
+			return es, nil
+		}
+
+		retryable, rateLimited := classifyError(err)
+		if !retryable || ctx.Err() != nil {
+			return nil, err
+		}
+		if i >= e.retry.MaxRetries {
+			if rateLimited {
+				return nil, fmt.Errorf("%w: %s", ErrRateLimitExceeded, err)
+			}
+			return nil, err
+		}
+
+		// Sleep for a random duration between backoff/2 and backoff.
+		d := backoff/2 + time.Duration(rand.Int64N(int64(backoff/2)+1))
+		e.log.V(1).Info("Retrying embedding request", "attempt", i+1, "backoff", d, "error", err.Error())
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(d):
+		}
+		backoff = min(backoff*2, e.retry.MaxBackoff)
+	}
+}
+
+// classifyError returns whether the error is transient and whether it is caused by rate limiting or overload.
+func classifyError(err error) (bool, bool) {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false, false
+	}
+
+	statusCode := -1
+	var apiErr *openai.APIError
+	var reqErr *openai.RequestError
+	var statusErr api.StatusError
+	switch {
+	case errors.As(err, &apiErr):
+		statusCode = apiErr.HTTPStatusCode
+	case errors.As(err, &reqErr):
+		statusCode = reqErr.HTTPStatusCode
+	case errors.As(err, &statusErr):
+		statusCode = statusErr.StatusCode
+	}
+
+	switch {
+	case statusCode == http.StatusTooManyRequests, statusCode == http.StatusServiceUnavailable:
+		return true, true
+	case statusCode >= http.StatusInternalServerError:
+		return true, false
+	case statusCode > 0:
+		return false, false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true, false
+	}
+	return false, false
+}
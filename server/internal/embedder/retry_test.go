@@ -0,0 +1,147 @@
+package embedder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/ollama/ollama/api"
+	"github.com/sashabaranov/go-openai"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddFile_Retry(t *testing.T) {
+	const (
+		collectionName = "collection0"
+		modelName      = "model1"
+	)
+	rateLimitErr := &openai.APIError{HTTPStatusCode: http.StatusTooManyRequests, Message: "too many requests"}
+	tcs := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "no error",
+			wantCalls: 1,
+		},
+		{
+			name:      "rate limited then succeeded",
+			errs:      []error{rateLimitErr, rateLimitErr},
+			wantCalls: 3,
+		},
+		{
+			name:      "overloaded then succeeded",
+			errs:      []error{fmt.Errorf("embed: %w", api.StatusError{StatusCode: http.StatusServiceUnavailable})},
+			wantCalls: 2,
+		},
+		{
+			name:      "rate limited until retries exhausted",
+			errs:      []error{rateLimitErr, rateLimitErr, rateLimitErr, rateLimitErr},
+			wantCalls: 4,
+			wantErr:   ErrRateLimitExceeded,
+		},
+		{
+			name:      "non-retryable error",
+			errs:      []error{&openai.APIError{HTTPStatusCode: http.StatusBadRequest, Message: "bad request"}},
+			wantCalls: 1,
+			wantErr:   errors.New("bad request"),
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			llm := &noopLLMClient{errs: tc.errs}
+			vs := &noopVStoreClient{collectionName: collectionName}
+			// Use a single batch so that the number of calls is deterministic.
+			e := New(
+				llm,
+				&fileS3Client{path: "testdata/test.txt"},
+				vs,
+				&noopParentChunkStore{},
+				nil, // reranker
+				newTestConfig(1000),
+				testr.New(t),
+			)
+			_, err := e.AddFile(context.Background(), collectionName, modelName, vectordb.MetricTypeL2, false, "file0", "test.txt", "key", newStaticChunkingStrategy(10, 2), nil)
+			assert.Equal(t, tc.wantCalls, llm.numBatchCalls)
+			if tc.wantErr != nil {
+				assert.Error(t, err)
+				if errors.Is(tc.wantErr, ErrRateLimitExceeded) {
+					assert.True(t, errors.Is(err, ErrRateLimitExceeded))
+				} else {
+					assert.False(t, errors.Is(err, ErrRateLimitExceeded))
+				}
+				assert.Empty(t, vs.texts)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEmpty(t, vs.texts)
+		})
+	}
+}
+
+func TestEmbedBatch_Legacy(t *testing.T) {
+	tcs := []struct {
+		name            string
+		legacy          bool
+		wantEmbeddings  [][]float32
+		wantLegacyCalls int
+	}{
+		{
+			name:           "batch",
+			legacy:         false,
+			wantEmbeddings: [][]float32{{0.1, 0.2}, {0.1, 0.2}},
+		},
+		{
+			name:            "legacy",
+			legacy:          true,
+			wantEmbeddings:  [][]float32{{1, 2}, {1, 2}},
+			wantLegacyCalls: 2,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			llm := &legacyNoopLLMClient{}
+			e := &E{llmClient: llm}
+			got, err := e.embedBatch(context.Background(), "model0", []string{"a", "b"}, tc.legacy)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantEmbeddings, got)
+			assert.Len(t, llm.legacyPrompts, tc.wantLegacyCalls)
+
+			q, err := e.embedQuery(context.Background(), "model0", "a", tc.legacy)
+			if tc.legacy {
+				assert.NoError(t, err)
+				assert.Equal(t, []float32{1, 2}, q)
+			} else {
+				// The batch API of the fake client has no embedding for the query.
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	// Clients without the legacy API always use the batch API.
+	e := &E{llmClient: &noopLLMClient{}}
+	got, err := e.embedBatch(context.Background(), "model0", []string{"a"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, [][]float32{{0.1, 0.2}}, got)
+}
+
+// legacyNoopLLMClient is a no-op LLM client that has the legacy embedding API.
+type legacyNoopLLMClient struct {
+	noopLLMClient
+
+	legacyPrompts []string
+}
+
+func (c *legacyNoopLLMClient) EmbedLegacy(ctx context.Context, modelName, prompt string) ([]float32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.legacyPrompts = append(c.legacyPrompts, prompt)
+	return []float32{1, 2}, nil
+}
@@ -0,0 +1,173 @@
+package embedder
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+// semanticSplitter splits text into sentences and groups neighboring sentences into chunks.
+// A new chunk starts where the cosine similarity between the embeddings of two neighboring sentences
+// is lower than the breakpoint percentile of all the similarities in the text, or where the chunk
+// would exceed maxTokens.
+type semanticSplitter struct {
+	// ctx is used to embed sentences as textsplitter.TextSplitter does not take a context.
+	ctx   context.Context
+	embed func(ctx context.Context, texts []string) ([][]float32, error)
+	tok   *tokenizer
+
+	breakpointPercentile float64
+	maxTokens            int
+	// capper splits single sentences that have more than maxTokens tokens.
+	capper textsplitter.TextSplitter
+}
+
+// SplitText implements textsplitter.TextSplitter.
+func (s *semanticSplitter) SplitText(text string) ([]string, error) {
+	spans := splitSentences(text)
+	if len(spans) == 0 {
+		return nil, nil
+	}
+
+	var sims []float64
+	if len(spans) > 1 {
+		sentences := make([]string, len(spans))
+		for i, sp := range spans {
+			sentences[i] = text[sp.start:sp.end]
+		}
+		vecs, err := s.embed(s.ctx, sentences)
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < len(vecs); i++ {
+			sims = append(sims, cosineSimilarity(vecs[i-1], vecs[i]))
+		}
+	}
+	threshold := percentile(sims, s.breakpointPercentile)
+
+	var chunks []string
+	appendChunk := func(first, last int) error {
+		chunk := text[spans[first].start:spans[last].end]
+		if first == last && s.tok.countTokens(chunk) > s.maxTokens {
+			cs, err := s.capper.SplitText(chunk)
+			if err != nil {
+				return err
+			}
+			chunks = append(chunks, cs...)
+			return nil
+		}
+		chunks = append(chunks, chunk)
+		return nil
+	}
+	first := 0
+	for i := 1; i < len(spans); i++ {
+		if sims[i-1] >= threshold && s.tok.countTokens(text[spans[first].start:spans[i].end]) <= s.maxTokens {
+			continue
+		}
+		if err := appendChunk(first, i-1); err != nil {
+			return nil, err
+		}
+		first = i
+	}
+	if err := appendChunk(first, len(spans)-1); err != nil {
+		return nil, err
+	}
+	return chunks, nil
+}
+
+func (e *E) newSemanticSplitter(ctx context.Context, modelName string, tok *tokenizer, cs ChunkingStrategy) *semanticSplitter {
+	capper := textsplitter.NewRecursiveCharacter()
+	capper.ChunkSize = int(cs.MaxChunkSizeTokens)
+	capper.ChunkOverlap = 0
+	capper.LenFunc = tok.countTokens
+	return &semanticSplitter{
+		ctx: ctx,
+		embed: func(ctx context.Context, texts []string) ([][]float32, error) {
+			// Breakpoints are found by the cosine distances, which do not depend on the normalization.
+			return e.embedTexts(ctx, modelName, texts, false /* legacy */)
+		},
+		tok:                  tok,
+		breakpointPercentile: float64(cs.BreakpointPercentile),
+		maxTokens:            int(cs.MaxChunkSizeTokens),
+		capper:               capper,
+	}
+}
+
+// span is a range of bytes in a text.
+type span struct {
+	start, end int
+}
+
+// splitSentences returns the spans of the sentences in the text. A sentence ends at ".", "!" or "?"
+// followed by a space, at a CJK full stop, or at a blank line. Spaces around sentences are excluded.
+func splitSentences(text string) []span {
+	var spans []span
+	start := -1
+	appendSpan := func(end int) {
+		trimmed := strings.TrimRightFunc(text[start:end], unicode.IsSpace)
+		if trimmed != "" {
+			spans = append(spans, span{start: start, end: start + len(trimmed)})
+		}
+		start = -1
+	}
+	for i, r := range text {
+		if start < 0 {
+			if unicode.IsSpace(r) {
+				continue
+			}
+			start = i
+		}
+		end := i + utf8.RuneLen(r)
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		switch r {
+		case '。', '！', '？':
+			appendSpan(end)
+		case '.', '!', '?':
+			if end == len(text) || unicode.IsSpace(next) {
+				appendSpan(end)
+			}
+		case '\n':
+			if next == '\n' {
+				appendSpan(end)
+			}
+		}
+	}
+	if start >= 0 {
+		appendSpan(len(text))
+	}
+	return spans
+}
+
+func cosineSimilarity(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		if i >= len(b) {
+			break
+		}
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// percentile returns the p-th percentile (0 to 100) of the values with linear interpolation.
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+}
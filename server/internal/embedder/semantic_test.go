@@ -0,0 +1,107 @@
+package embedder
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmc/langchaingo/textsplitter"
+)
+
+func TestSplitSentences(t *testing.T) {
+	text := "  Vector stores hold files. Version 1.2 is out!\nIs it?\n\nNew paragraph\n\nベクトルストアです。検索します。"
+	var got []string
+	for _, sp := range splitSentences(text) {
+		got = append(got, text[sp.start:sp.end])
+	}
+	want := []string{
+		"Vector stores hold files.",
+		"Version 1.2 is out!",
+		"Is it?",
+		"New paragraph",
+		"ベクトルストアです。",
+		"検索します。",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestSemanticSplitter(t *testing.T) {
+	// Sentences about cats and sentences about stocks have orthogonal embeddings.
+	vectors := map[string][]float32{
+		"Cats sleep a lot.":      {1, 0},
+		"Cats chase mice.":       {0.9, 0.1},
+		"Cats purr.":             {1, 0.1},
+		"Stocks fell today.":     {0, 1},
+		"Stocks may rise again.": {0.1, 0.9},
+	}
+	text := "Cats sleep a lot. Cats chase mice. Cats purr. Stocks fell today. Stocks may rise again."
+
+	tcs := []struct {
+		name      string
+		maxTokens int
+		want      []string
+	}{
+		{
+			name:      "split at topic change",
+			maxTokens: 100,
+			want: []string{
+				"Cats sleep a lot. Cats chase mice. Cats purr.",
+				"Stocks fell today. Stocks may rise again.",
+			},
+		},
+		{
+			name:      "max tokens",
+			maxTokens: 10,
+			want: []string{
+				"Cats sleep a lot. Cats chase mice.",
+				"Cats purr.",
+				"Stocks fell today. Stocks may rise again.",
+			},
+		},
+		{
+			name:      "long sentence",
+			maxTokens: 5,
+			want: []string{
+				"Cats sleep",
+				"a lot.",
+				"Cats chase mice.",
+				"Cats purr.",
+				"Stocks fell today.",
+				"Stocks may",
+				"rise again.",
+			},
+		},
+	}
+
+	tok := newTestTokenizer(t)
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			capper := textsplitter.NewRecursiveCharacter()
+			capper.ChunkSize = tc.maxTokens
+			capper.ChunkOverlap = 0
+			capper.LenFunc = tok.countTokens
+			s := &semanticSplitter{
+				ctx: context.Background(),
+				embed: func(ctx context.Context, texts []string) ([][]float32, error) {
+					var vs [][]float32
+					for _, text := range texts {
+						v, ok := vectors[text]
+						if !ok {
+							return nil, fmt.Errorf("unexpected sentence %q", text)
+						}
+						vs = append(vs, v)
+					}
+					return vs, nil
+				},
+				tok:                  tok,
+				breakpointPercentile: 5,
+				maxTokens:            tc.maxTokens,
+				capper:               capper,
+			}
+			got, err := s.SplitText(text)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package main
+
+import "fmt"
+
+// Greeting is a greeting message.
+type Greeting struct {
+	Name string
+}
+
+func hello(g Greeting) {
+	fmt.Println("Hello,", g.Name)
+}
+
+func main() {
+	hello(Greeting{Name: "world"})
+}
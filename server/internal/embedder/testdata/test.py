@@ -0,0 +1,10 @@
+class Greeting:
+    def __init__(self, name):
+        self.name = name
+
+    def hello(self):
+        print("Hello,", self.name)
+
+
+def main():
+    Greeting("world").hello()
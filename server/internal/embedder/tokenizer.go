@@ -0,0 +1,69 @@
+package embedder
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pkoukk/tiktoken-go"
+	tiktoken_loader "github.com/pkoukk/tiktoken-go-loader"
+)
+
+func init() {
+	// Load BPE vocabularies from the files bundled in the binary instead of downloading them.
+	tiktoken.SetBpeLoader(tiktoken_loader.NewOfflineLoader())
+}
+
+// tokenizer counts tokens with a tiktoken-compatible BPE vocabulary.
+type tokenizer struct {
+	enc *tiktoken.Tiktoken
+}
+
+func newTokenizer(encoding string) (*tokenizer, error) {
+	enc, err := tiktoken.GetEncoding(encoding)
+	if err != nil {
+		return nil, fmt.Errorf("get encoding %q: %s", encoding, err)
+	}
+	return &tokenizer{enc: enc}, nil
+}
+
+// countTokens returns the number of tokens in the text. Special tokens are counted as ordinary text.
+func (t *tokenizer) countTokens(text string) int {
+	return len(t.enc.EncodeOrdinary(text))
+}
+
+// tokenizers creates tokenizers for embedding models and caches them by encoding.
+type tokenizers struct {
+	defaultEncoding string
+	modelEncodings  map[string]string
+
+	mu          sync.Mutex
+	byEncodings map[string]*tokenizer
+}
+
+func newTokenizers(defaultEncoding string, modelEncodings map[string]string) *tokenizers {
+	return &tokenizers{
+		defaultEncoding: defaultEncoding,
+		modelEncodings:  modelEncodings,
+		byEncodings:     map[string]*tokenizer{},
+	}
+}
+
+// get returns the tokenizer for the model. The default encoding is used if no encoding is configured for the model.
+func (ts *tokenizers) get(modelName string) (*tokenizer, error) {
+	encoding, ok := ts.modelEncodings[modelName]
+	if !ok {
+		encoding = ts.defaultEncoding
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if t, ok := ts.byEncodings[encoding]; ok {
+		return t, nil
+	}
+	t, err := newTokenizer(encoding)
+	if err != nil {
+		return nil, err
+	}
+	ts.byEncodings[encoding] = t
+	return t, nil
+}
@@ -0,0 +1,32 @@
+package embedder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTokenizer(t *testing.T) *tokenizer {
+	tok, err := newTokenizer("cl100k_base")
+	assert.NoError(t, err)
+	return tok
+}
+
+func TestTokenizers(t *testing.T) {
+	ts := newTokenizers("cl100k_base", map[string]string{"model1": "p50k_base"})
+
+	t0, err := ts.get("model0")
+	assert.NoError(t, err)
+	t1, err := ts.get("model1")
+	assert.NoError(t, err)
+	assert.NotSame(t, t0, t1)
+	// "ベクトル" is encoded differently by the two vocabularies.
+	assert.NotEqual(t, t0.countTokens("ベクトル"), t1.countTokens("ベクトル"))
+
+	got, err := ts.get("model2")
+	assert.NoError(t, err)
+	assert.Same(t, t0, got)
+
+	_, err = newTokenizers("unknown", nil).get("model0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package milvus
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+)
+
+var comparisonOperators = map[vectordb.FilterType]string{
+	vectordb.FilterTypeEq:  "==",
+	vectordb.FilterTypeNe:  "!=",
+	vectordb.FilterTypeGt:  ">",
+	vectordb.FilterTypeGte: ">=",
+	vectordb.FilterTypeLt:  "<",
+	vectordb.FilterTypeLte: "<=",
+}
+
+// filterExpr translates the filter into a boolean expression on the attributes column.
+func filterExpr(f *vectordb.Filter) (string, error) {
+	if err := f.Validate(); err != nil {
+		return "", err
+	}
+	return filterCondition(f)
+}
+
+func filterCondition(f *vectordb.Filter) (string, error) {
+	switch f.Type {
+	case vectordb.FilterTypeAnd, vectordb.FilterTypeOr:
+		var exprs []string
+		for _, sf := range f.Filters {
+			e, err := filterCondition(sf)
+			if err != nil {
+				return "", err
+			}
+			exprs = append(exprs, "("+e+")")
+		}
+		op := " && "
+		if f.Type == vectordb.FilterTypeOr {
+			op = " || "
+		}
+		return strings.Join(exprs, op), nil
+	case vectordb.FilterTypeIn:
+		var ls []string
+		for _, v := range f.Value.([]any) {
+			l, err := literal(v)
+			if err != nil {
+				return "", err
+			}
+			ls = append(ls, l)
+		}
+		return fmt.Sprintf("%s in [%s]", attributeRef(f.Key), strings.Join(ls, ", ")), nil
+	}
+
+	op, ok := comparisonOperators[f.Type]
+	if !ok {
+		return "", fmt.Errorf("unsupported filter type %q", f.Type)
+	}
+	l, err := literal(f.Value)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s", attributeRef(f.Key), op, l), nil
+}
+
+func attributeRef(key string) string {
+	return fmt.Sprintf("%s[%s]", attributesColName, strconv.Quote(key))
+}
+
+func literal(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("unsupported filter value %v", v)
+	}
+}
@@ -0,0 +1,81 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterExpr(t *testing.T) {
+	tcs := []struct {
+		name    string
+		filter  *vectordb.Filter
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "eq string",
+			filter: &vectordb.Filter{Type: vectordb.FilterTypeEq, Key: "author", Value: "alice"},
+			want:   `attributes["author"] == "alice"`,
+		},
+		{
+			name:   "ne bool",
+			filter: &vectordb.Filter{Type: vectordb.FilterTypeNe, Key: "draft", Value: true},
+			want:   `attributes["draft"] != true`,
+		},
+		{
+			name:   "gte number",
+			filter: &vectordb.Filter{Type: vectordb.FilterTypeGte, Key: "year", Value: 2024.0},
+			want:   `attributes["year"] >= 2024`,
+		},
+		{
+			name:   "in",
+			filter: &vectordb.Filter{Type: vectordb.FilterTypeIn, Key: "lang", Value: []any{"en", "ja"}},
+			want:   `attributes["lang"] in ["en", "ja"]`,
+		},
+		{
+			name:   "quoted",
+			filter: &vectordb.Filter{Type: vectordb.FilterTypeEq, Key: `a"b`, Value: `c"d`},
+			want:   `attributes["a\"b"] == "c\"d"`,
+		},
+		{
+			name: "compound",
+			filter: &vectordb.Filter{
+				Type: vectordb.FilterTypeAnd,
+				Filters: []*vectordb.Filter{
+					{Type: vectordb.FilterTypeLt, Key: "year", Value: 2024.5},
+					{
+						Type: vectordb.FilterTypeOr,
+						Filters: []*vectordb.Filter{
+							{Type: vectordb.FilterTypeEq, Key: "author", Value: "alice"},
+							{Type: vectordb.FilterTypeEq, Key: "author", Value: "bob"},
+						},
+					},
+				},
+			},
+			want: `(attributes["year"] < 2024.5) && ((attributes["author"] == "alice") || (attributes["author"] == "bob"))`,
+		},
+		{
+			name:    "unsupported type",
+			filter:  &vectordb.Filter{Type: "like", Key: "author", Value: "a%"},
+			wantErr: true,
+		},
+		{
+			name:    "gt string",
+			filter:  &vectordb.Filter{Type: vectordb.FilterTypeGt, Key: "author", Value: "alice"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := filterExpr(tc.filter)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
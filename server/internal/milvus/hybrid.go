@@ -0,0 +1,284 @@
+package milvus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"gorm.io/gorm"
+)
+
+const (
+	// bm25K1 and bm25B are the term frequency saturation and the length normalization parameters of BM25.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// defaultRRFK is the constant of reciprocal rank fusion. Larger values give lower ranks more weight.
+	defaultRRFK = 60
+)
+
+// keywordStore stores the statistics of the terms in the chunks of collections. Keyword search weights the
+// terms by BM25 with them.
+type keywordStore interface {
+	GetOrCreateKeywordTerms(collectionName string, terms []string) ([]*store.KeywordTerm, error)
+	ListKeywordTerms(collectionName string, terms []string) ([]*store.KeywordTerm, error)
+	GetKeywordCollection(collectionName string) (*store.KeywordCollection, error)
+	AddKeywordFile(f *store.KeywordFile, documentFrequencies map[uint]int64) error
+	DeleteKeywordFile(collectionName, fileID string) error
+	RenameKeywordCollection(oldName, newName string) error
+	DeleteKeywordCollection(collectionName string) error
+}
+
+// keywordFile is what the chunks of a file add to the statistics of a collection.
+type keywordFile struct {
+	file *store.KeywordFile
+	// documentFrequencies maps the IDs of the terms to the number of chunks of the file that contain the terms.
+	documentFrequencies map[uint]int64
+}
+
+// reranker returns the reranker that merges the results by the fusion.
+func reranker(f vectordb.Fusion) (client.Reranker, error) {
+	switch f.Type {
+	case vectordb.FusionTypeRRF, "":
+		return client.NewRRFReranker().WithK(defaultRRFK), nil
+	case vectordb.FusionTypeWeighted:
+		if f.VectorWeight < 0 || f.VectorWeight > 1 {
+			return nil, fmt.Errorf("vector weight must be between 0 and 1")
+		}
+		return client.NewWeightedReranker([]float64{f.VectorWeight, 1 - f.VectorWeight}), nil
+	default:
+		return nil, fmt.Errorf("unsupported fusion type %q", f.Type)
+	}
+}
+
+// HybridSearch searches for the documents with similar vectors and the documents that contain the terms of
+// the query, and merges the results. The matched documents are returned in the order of the merged scores.
+// Only the vector search is run for collections that do not have the sparse vector column and for queries
+// that have no terms in the collection. The index must be the one that the collection is indexed with. The vectors of the
+// documents are also returned if withVectors is true.
+func (s *S) HybridSearch(
+	ctx context.Context,
+	collectionName string,
+	index vectordb.Index,
+	vectors []float32,
+	query string,
+	numDocuments int,
+	filter *vectordb.Filter,
+	fusion vectordb.Fusion,
+	withVectors bool,
+) ([]vectordb.Document, error) {
+	hasSparse, err := s.hasField(ctx, collectionName, sparseColName)
+	if err != nil {
+		return nil, err
+	}
+	if !hasSparse {
+		// Collections created by older versions do not have the sparse vector column.
+		s.log.Info("Collection does not support keyword search. Falling back to vector search", "collection", collectionName)
+		return s.Search(ctx, collectionName, index, vectors, numDocuments, filter, withVectors)
+	}
+	sparse, err := s.querySparseVector(collectionName, query)
+	if err != nil {
+		return nil, err
+	}
+	if sparse.Len() == 0 {
+		// Milvus rejects an empty sparse vector, and no document would match it anyway.
+		s.log.Info("No term of the query appears in the collection. Falling back to vector search", "collection", collectionName)
+		return s.Search(ctx, collectionName, index, vectors, numDocuments, filter, withVectors)
+	}
+	index = index.WithDefaults()
+	mt, err := entityMetricType(index.MetricType)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := searchParam(index, numDocuments)
+	if err != nil {
+		return nil, err
+	}
+	reranker, err := reranker(fusion)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := s.searchExpr(ctx, collectionName, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.client.LoadCollection(ctx, collectionName, false); err != nil {
+		return nil, fmt.Errorf("load collection: %s", err)
+	}
+	defer func() {
+		if err := s.client.ReleaseCollection(ctx, collectionName); err != nil {
+			s.log.Error(err, "Failed to release collection")
+		}
+	}()
+
+	sparseSP, err := entity.NewIndexSparseInvertedSearchParam(0 /* dropRatio */)
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, err := s.outputFields(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	if withVectors {
+		outputFields = append(outputFields, vectorColName)
+	}
+
+	results, err := s.client.HybridSearch(
+		ctx,
+		collectionName,
+		nil, /* partitions */
+		numDocuments,
+		outputFields,
+		reranker,
+		[]*client.ANNSearchRequest{
+			client.NewANNSearchRequest(vectorColName, mt, expr, []entity.Vector{entity.FloatVector(vectors)}, sp, numDocuments),
+			client.NewANNSearchRequest(sparseColName, entity.IP, expr, []entity.Vector{sparse}, sparseSP, numDocuments),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return toSearchDocuments(results, func(d *vectordb.Document, score float32) {
+		d.Score = normalizeFusionScore(fusion, score)
+	})
+}
+
+// tokenize splits the text into lower-cased terms. A term is a sequence of letters, digits and underscores
+// so that identifiers are kept as single terms.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+}
+
+// documentSparseVectors returns the sparse vectors of the texts of the files, and what the texts of each file
+// add to the statistics of the collection. The terms that do not exist in the collection are created so that
+// each term has its own dimension. The average length of the chunks is the one after the texts are added.
+func (s *S) documentSparseVectors(collectionName string, files, texts []string) ([]entity.SparseEmbedding, []keywordFile, error) {
+	termsOfTexts := make([][]string, len(texts))
+	var allTerms []string
+	for i, text := range texts {
+		termsOfTexts[i] = tokenize(text)
+		allTerms = append(allTerms, termsOfTexts[i]...)
+	}
+	kts, err := s.keywords.GetOrCreateKeywordTerms(collectionName, allTerms)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get or create keyword terms: %s", err)
+	}
+	termIDs := map[string]uint{}
+	for _, kt := range kts {
+		termIDs[kt.Term] = kt.ID
+	}
+
+	numDocuments, numTerms := int64(len(texts)), int64(len(allTerms))
+	kc, err := s.keywords.GetKeywordCollection(collectionName)
+	if err == nil {
+		numDocuments += kc.NumDocuments
+		numTerms += kc.NumTerms
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, fmt.Errorf("get keyword collection: %s", err)
+	}
+	avgDocLength := max(float64(numTerms)/float64(max(numDocuments, 1)), 1)
+
+	var (
+		svs []entity.SparseEmbedding
+		kfs []keywordFile
+	)
+	kfsByFile := map[string]int{}
+	for i, terms := range termsOfTexts {
+		sv, err := sparseDocumentVector(terms, termIDs, avgDocLength)
+		if err != nil {
+			return nil, nil, err
+		}
+		svs = append(svs, sv)
+
+		j, ok := kfsByFile[files[i]]
+		if !ok {
+			j = len(kfs)
+			kfsByFile[files[i]] = j
+			kfs = append(kfs, keywordFile{
+				file:                &store.KeywordFile{CollectionName: collectionName, FileID: files[i]},
+				documentFrequencies: map[uint]int64{},
+			})
+		}
+		kf := kfs[j]
+		kf.file.NumDocuments++
+		kf.file.NumTerms += int64(len(terms))
+		seen := map[string]bool{}
+		for _, t := range terms {
+			if !seen[t] {
+				seen[t] = true
+				kf.documentFrequencies[termIDs[t]]++
+			}
+		}
+	}
+	return svs, kfs, nil
+}
+
+// querySparseVector returns the sparse vector of a query. The vector is empty if no term of the query
+// appears in the chunks of the collection.
+func (s *S) querySparseVector(collectionName, query string) (entity.SparseEmbedding, error) {
+	kts, err := s.keywords.ListKeywordTerms(collectionName, tokenize(query))
+	if err != nil {
+		return nil, fmt.Errorf("list keyword terms: %s", err)
+	}
+	var numDocuments int64
+	kc, err := s.keywords.GetKeywordCollection(collectionName)
+	if err == nil {
+		numDocuments = kc.NumDocuments
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("get keyword collection: %s", err)
+	}
+	return sparseQueryVector(kts, numDocuments)
+}
+
+// sparseDocumentVector returns the sparse vector of a chunk that consists of the terms. The dimension of
+// each term is its ID, and each term is weighted by the term frequency part of BM25. The query vector weights
+// the terms by their inverse document frequencies, so the inner product of the vectors is the BM25 score.
+func sparseDocumentVector(terms []string, termIDs map[string]uint, avgDocLength float64) (entity.SparseEmbedding, error) {
+	tfs := map[uint32]float64{}
+	for _, t := range terms {
+		tfs[uint32(termIDs[t])]++
+	}
+	norm := bm25K1 * (1 - bm25B + bm25B*float64(len(terms))/avgDocLength)
+	var (
+		positions []uint32
+		values    []float32
+	)
+	for p, tf := range tfs {
+		positions = append(positions, p)
+		values = append(values, float32(tf*(bm25K1+1)/(tf+norm)))
+	}
+	return entity.NewSliceSparseEmbedding(positions, values)
+}
+
+// sparseQueryVector returns the sparse vector of a query that consists of the terms. Each term that appears
+// in the chunks is weighted by its inverse document frequency in the numDocuments chunks of the collection.
+func sparseQueryVector(terms []*store.KeywordTerm, numDocuments int64) (entity.SparseEmbedding, error) {
+	var (
+		positions []uint32
+		values    []float32
+	)
+	for _, t := range terms {
+		if t.DocumentFrequency <= 0 {
+			continue
+		}
+		positions = append(positions, uint32(t.ID))
+		values = append(values, float32(idf(t.DocumentFrequency, numDocuments)))
+	}
+	return entity.NewSliceSparseEmbedding(positions, values)
+}
+
+// idf returns the inverse document frequency of BM25 for a term that appears in df of n chunks.
+func idf(df, n int64) float64 {
+	return math.Log(1 + (float64(n-df)+0.5)/(float64(df)+0.5))
+}
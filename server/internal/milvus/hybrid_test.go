@@ -0,0 +1,167 @@
+package milvus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTokenize(t *testing.T) {
+	got := tokenize("Fix ERR_CONN_RESET in parseConfig() (SKU-1234).")
+	assert.Equal(t, []string{"fix", "err_conn_reset", "in", "parseconfig", "sku", "1234"}, got)
+}
+
+func TestSparseDocumentVector(t *testing.T) {
+	termIDs := map[string]uint{"error": 1, "e42": 2, "word": 3}
+	v, err := sparseDocumentVector(tokenize("error E42 error E42 error"), termIDs, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v.Len())
+
+	errWeight := sparseWeight(t, v, 1)
+	e42Weight := sparseWeight(t, v, 2)
+	assert.Greater(t, errWeight, e42Weight)
+	// The term frequency saturates.
+	assert.Less(t, errWeight, float32(bm25K1+1))
+
+	// A term in a shorter chunk has a larger weight.
+	short, err := sparseDocumentVector(tokenize("error"), termIDs, 5)
+	assert.NoError(t, err)
+	long, err := sparseDocumentVector(tokenize("error"+strings.Repeat(" word", 10)), termIDs, 5)
+	assert.NoError(t, err)
+	assert.Greater(t, sparseWeight(t, short, 1), sparseWeight(t, long, 1))
+}
+
+func TestSparseQueryVector(t *testing.T) {
+	v, err := sparseQueryVector([]*store.KeywordTerm{
+		{Model: gorm.Model{ID: 1}, Term: "error", DocumentFrequency: 90},
+		{Model: gorm.Model{ID: 2}, Term: "e42", DocumentFrequency: 1},
+		// Terms that no longer appear in the chunks are ignored.
+		{Model: gorm.Model{ID: 3}, Term: "gone", DocumentFrequency: 0},
+	}, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v.Len())
+	// A rare term has a larger weight.
+	assert.Greater(t, sparseWeight(t, v, 2), sparseWeight(t, v, 1))
+
+	v, err = sparseQueryVector(nil, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, v.Len())
+}
+
+func TestKeywordSearch_BM25(t *testing.T) {
+	const collectionName = "collection0"
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+	s := &S{keywords: st}
+
+	// Every chunk contains "error", and only one chunk contains "e42".
+	texts := []string{
+		"error error error in the parser",
+		"error e42 in the loader",
+	}
+	for i := 0; i < 20; i++ {
+		texts = append(texts, fmt.Sprintf("error in module %d", i))
+	}
+	var docs []entity.SparseEmbedding
+	for i, text := range texts {
+		// Each chunk is in its own file so that the statistics are updated as the collection is filled.
+		fileID := fmt.Sprintf("file%d", i)
+		svs, kfs, err := s.documentSparseVectors(collectionName, []string{fileID}, []string{text})
+		assert.NoError(t, err)
+		for _, kf := range kfs {
+			err := st.AddKeywordFile(kf.file, kf.documentFrequencies)
+			assert.NoError(t, err)
+		}
+		docs = append(docs, svs[0])
+	}
+
+	q, err := s.querySparseVector(collectionName, "error E42")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, q.Len())
+	// The chunk with the rare term ranks above the chunk that repeats the common term.
+	assert.Greater(t, innerProduct(q, docs[1]), innerProduct(q, docs[0]))
+
+	// Unknown terms are not searched.
+	q, err = s.querySparseVector(collectionName, "unknown ???")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, q.Len())
+
+	// The statistics of a deleted file are subtracted.
+	err = st.DeleteKeywordFile(collectionName, "file1")
+	assert.NoError(t, err)
+	q, err = s.querySparseVector(collectionName, "e42")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestFusionReranker(t *testing.T) {
+	tcs := []struct {
+		name    string
+		fusion  vectordb.Fusion
+		wantErr bool
+	}{
+		{
+			name:   "default",
+			fusion: vectordb.Fusion{},
+		},
+		{
+			name:   "rrf",
+			fusion: vectordb.Fusion{Type: vectordb.FusionTypeRRF},
+		},
+		{
+			name:   "weighted",
+			fusion: vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: 0.3},
+		},
+		{
+			name:    "invalid weight",
+			fusion:  vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: 1.3},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			fusion:  vectordb.Fusion{Type: "max"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := reranker(tc.fusion)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func sparseWeight(t *testing.T, v entity.SparseEmbedding, id uint32) float32 {
+	for i := 0; i < v.Len(); i++ {
+		pos, val, ok := v.Get(i)
+		assert.True(t, ok)
+		if pos == id {
+			return val
+		}
+	}
+	t.Fatalf("term %d not found", id)
+	return 0
+}
+
+func innerProduct(a, b entity.SparseEmbedding) float32 {
+	var p float32
+	for i := 0; i < a.Len(); i++ {
+		pos, val, _ := a.Get(i)
+		for j := 0; j < b.Len(); j++ {
+			if bpos, bval, _ := b.Get(j); bpos == pos {
+				p += val * bval
+			}
+		}
+	}
+	return p
+}
@@ -0,0 +1,63 @@
+package milvus
+
+import (
+	"fmt"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// ValidateIndex returns an error if the index cannot be built for vectors of the dimensions.
+func (s *S) ValidateIndex(index vectordb.Index, dimensions int) error {
+	if err := index.Validate(dimensions); err != nil {
+		return err
+	}
+	index = index.WithDefaults()
+	if _, err := entityIndex(index); err != nil {
+		return err
+	}
+	if _, err := searchParam(index, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func entityIndex(i vectordb.Index) (entity.Index, error) {
+	mt, err := entityMetricType(i.MetricType)
+	if err != nil {
+		return nil, err
+	}
+	switch i.Type {
+	case vectordb.IndexTypeIVFFlat:
+		return entity.NewIndexIvfFlat(mt, i.NList)
+	case vectordb.IndexTypeFlat:
+		return entity.NewIndexFlat(mt)
+	case vectordb.IndexTypeHNSW:
+		return entity.NewIndexHNSW(mt, i.M, i.EFConstruction)
+	case vectordb.IndexTypeIVFPQ:
+		return entity.NewIndexIvfPQ(mt, i.NList, i.M, i.NBits)
+	case vectordb.IndexTypeDiskANN:
+		return entity.NewIndexDISKANN(mt)
+	default:
+		return nil, fmt.Errorf("unsupported index type %q", i.Type)
+	}
+}
+
+// searchParam returns the parameter of a search with the index for the number of documents. Graph indexes
+// consider at least as many candidates as the number of documents.
+func searchParam(i vectordb.Index, numDocuments int) (entity.SearchParam, error) {
+	switch i.Type {
+	case vectordb.IndexTypeIVFFlat:
+		return entity.NewIndexIvfFlatSearchParam(i.NProbe)
+	case vectordb.IndexTypeFlat:
+		return entity.NewIndexFlatSearchParam()
+	case vectordb.IndexTypeHNSW:
+		return entity.NewIndexHNSWSearchParam(max(i.EF, numDocuments))
+	case vectordb.IndexTypeIVFPQ:
+		return entity.NewIndexIvfPQSearchParam(i.NProbe)
+	case vectordb.IndexTypeDiskANN:
+		return entity.NewIndexDISKANNSearchParam(max(i.SearchList, numDocuments))
+	default:
+		return nil, fmt.Errorf("unsupported index type %q", i.Type)
+	}
+}
@@ -0,0 +1,94 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIndex(t *testing.T) {
+	tcs := []struct {
+		name    string
+		index   vectordb.Index
+		wantErr bool
+	}{
+		{
+			name:  "default",
+			index: vectordb.Index{},
+		},
+		{
+			name:  "ivf pq",
+			index: vectordb.Index{Type: vectordb.IndexTypeIVFPQ, M: 16, NBits: 8},
+		},
+		{
+			name:    "too large m of hnsw",
+			index:   vectordb.Index{Type: vectordb.IndexTypeHNSW, M: 128},
+			wantErr: true,
+		},
+		{
+			name:    "too large nlist",
+			index:   vectordb.Index{Type: vectordb.IndexTypeIVFFlat, NList: 100000},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			index:   vectordb.Index{Type: "scann"},
+			wantErr: true,
+		},
+	}
+	s := &S{}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := s.ValidateIndex(tc.index, 384)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSearchParam(t *testing.T) {
+	tcs := []struct {
+		name         string
+		index        vectordb.Index
+		numDocuments int
+		want         map[string]any
+	}{
+		{
+			name:         "ivf flat",
+			index:        vectordb.Index{Type: vectordb.IndexTypeIVFFlat, NProbe: 32},
+			numDocuments: 100,
+			want:         map[string]any{"nprobe": 32},
+		},
+		{
+			name:         "hnsw",
+			index:        vectordb.Index{Type: vectordb.IndexTypeHNSW, EF: 64},
+			numDocuments: 10,
+			want:         map[string]any{"ef": 64},
+		},
+		{
+			name:         "hnsw with more documents than ef",
+			index:        vectordb.Index{Type: vectordb.IndexTypeHNSW, EF: 64},
+			numDocuments: 100,
+			want:         map[string]any{"ef": 100},
+		},
+		{
+			name:         "diskann with more documents than search list",
+			index:        vectordb.Index{Type: vectordb.IndexTypeDiskANN, SearchList: 50},
+			numDocuments: 80,
+			want:         map[string]any{"search_list": 80},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			sp, err := searchParam(tc.index, tc.numDocuments)
+			assert.NoError(t, err)
+			for k, v := range tc.want {
+				assert.Equal(t, v, sp.Params()[k])
+			}
+		})
+	}
+}
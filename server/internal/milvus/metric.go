@@ -0,0 +1,21 @@
+package milvus
+
+import (
+	"fmt"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+func entityMetricType(m vectordb.MetricType) (entity.MetricType, error) {
+	switch m {
+	case vectordb.MetricTypeL2, "":
+		return entity.L2, nil
+	case vectordb.MetricTypeIP:
+		return entity.IP, nil
+	case vectordb.MetricTypeCosine:
+		return entity.COSINE, nil
+	default:
+		return "", fmt.Errorf("unsupported metric type %q", m)
+	}
+}
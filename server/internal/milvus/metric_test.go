@@ -0,0 +1,49 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityMetricType(t *testing.T) {
+	tcs := []struct {
+		metric  vectordb.MetricType
+		want    entity.MetricType
+		wantErr bool
+	}{
+		{
+			metric: "",
+			want:   entity.L2,
+		},
+		{
+			metric: vectordb.MetricTypeL2,
+			want:   entity.L2,
+		},
+		{
+			metric: vectordb.MetricTypeIP,
+			want:   entity.IP,
+		},
+		{
+			metric: vectordb.MetricTypeCosine,
+			want:   entity.COSINE,
+		},
+		{
+			metric:  "hamming",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(string(tc.metric), func(t *testing.T) {
+			got, err := entityMetricType(tc.metric)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -2,36 +2,51 @@ package milvus
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/go-logr/logr"
 	"github.com/llmariner/common/pkg/db"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/milvus-io/milvus-sdk-go/v2/client"
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 )
 
 const (
-	defaultShardNum                             = 1
-	vectorColName                               = "vector"
-	primaryKeyColName                           = "pk"
-	fileIDColName                               = "fileID"
-	textColName                                 = "text"
-	maxVarCharLength                            = 4096 * 4 // maxMaxChunkSizeTokens * charactersPerToken
-	defaultMetricType         entity.MetricType = entity.L2
-	defaultIvfFlatNList                         = 128
-	defaultIvfFlatSearchParam                   = 16
+	defaultShardNum   = 1
+	vectorColName     = "vector"
+	primaryKeyColName = "pk"
+	fileIDColName     = "fileID"
+	textColName       = "text"
+	metadataColName   = "metadata"
+	parentIDColName   = "parentID"
+	chunkIndexColName = "chunkIndex"
+	attributesColName = "attributes"
+	sparseColName     = "sparse"
+	// maxVarCharLength is the maximum length in bytes of a VarChar field in Milvus. Collections created by older
+	// versions have a limit of 16384 bytes, which texts are truncated to when they are inserted.
+	maxVarCharLength = 65535
+	// updateBatchSize is the number of documents that are read at once when documents are updated.
+	updateBatchSize = 1000
 )
 
 // S wraps Milvus client.
 type S struct {
-	client client.Client
-	log    logr.Logger
+	client   client.Client
+	keywords keywordStore
+	log      logr.Logger
 }
 
-// New creates an active client connection to the Milvus server.
-func New(ctx context.Context, cfg db.Config, log logr.Logger) (*S, error) {
+var _ vectordb.Client = &S{}
+
+// New creates an active client connection to the Milvus server. The statistics of the terms that keyword
+// search uses are stored in ks.
+func New(ctx context.Context, cfg db.Config, ks keywordStore, log logr.Logger) (*S, error) {
 	log = log.WithName("milvus")
 
 	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
@@ -50,13 +65,19 @@ func New(ctx context.Context, cfg db.Config, log logr.Logger) (*S, error) {
 	log.Info("Connected to Milvus")
 
 	return &S{
-		client: c,
-		log:    log,
+		client:   c,
+		keywords: ks,
+		log:      log,
 	}, nil
 }
 
-// CreateVectorStore creates a new collection in milvus.
-func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int) (int64, error) {
+// CreateVectorStore creates a new collection in milvus. The vectors of the collection are indexed with the index.
+func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int, index vectordb.Index) (int64, error) {
+	idx, err := entityIndex(index.WithDefaults())
+	if err != nil {
+		return 0, fmt.Errorf("new index: %s", err)
+	}
+
 	schema := &entity.Schema{
 		CollectionName: name,
 		AutoID:         true,
@@ -81,6 +102,28 @@ func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int)
 					entity.TypeParamMaxLength: strconv.Itoa(maxVarCharLength),
 				},
 			},
+			{
+				Name:     metadataColName,
+				DataType: entity.FieldTypeJSON,
+			},
+			{
+				// parentID references the parent chunk of a child chunk. It is empty for other chunks.
+				Name:     parentIDColName,
+				DataType: entity.FieldTypeVarChar,
+				TypeParams: map[string]string{
+					entity.TypeParamMaxLength: strconv.Itoa(maxVarCharLength),
+				},
+			},
+			{
+				// chunkIndex is the position of a chunk in its file.
+				Name:     chunkIndexColName,
+				DataType: entity.FieldTypeInt64,
+			},
+			{
+				// attributes are the attributes of the file that a chunk comes from. They are used by filters.
+				Name:     attributesColName,
+				DataType: entity.FieldTypeJSON,
+			},
 			{
 				Name:     vectorColName,
 				DataType: entity.FieldTypeFloatVector,
@@ -88,23 +131,28 @@ func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int)
 					entity.TypeParamDim: strconv.Itoa(dimensions),
 				},
 			},
+			{
+				// sparse is the sparse vector of the terms in a chunk. It is used by keyword search.
+				Name:     sparseColName,
+				DataType: entity.FieldTypeSparseVector,
+			},
 		},
 	}
 
-	err := s.client.CreateCollection(ctx, schema, defaultShardNum)
-	if err != nil {
+	if err := s.client.CreateCollection(ctx, schema, defaultShardNum); err != nil {
 		return 0, err
 	}
 
-	// TODO(guangrui): Experiment with other type of indexing and tuning.
-	// Refer to https://milvus.io/docs/index.md#Indexes-supported-in-Milvus
-	idx, err := entity.NewIndexIvfFlat(defaultMetricType, defaultIvfFlatNList)
-	if err != nil {
-		return 0, fmt.Errorf("new ivf flat index: %s", err)
-	}
 	if err := s.client.CreateIndex(ctx, name, vectorColName, idx, false); err != nil {
 		return 0, fmt.Errorf("create index:: %s", err)
 	}
+	sparseIdx, err := entity.NewIndexSparseInverted(entity.IP, 0 /* dropRatio */)
+	if err != nil {
+		return 0, fmt.Errorf("new sparse inverted index: %s", err)
+	}
+	if err := s.client.CreateIndex(ctx, name, sparseColName, sparseIdx, false); err != nil {
+		return 0, fmt.Errorf("create sparse index: %s", err)
+	}
 
 	c, err := s.client.DescribeCollection(ctx, name)
 	if err != nil {
@@ -115,6 +163,38 @@ func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int)
 	return c.ID, nil
 }
 
+// RebuildIndex replaces the index of the vectors of a collection with a new index. The metric of the new
+// index must be the same as the metric of the old one as the stored vectors are not changed. Milvus allows
+// only one index on a vector field, so the old index is dropped first and searches fail until the new index
+// is built. The old index is restored if the new index cannot be built.
+func (s *S) RebuildIndex(ctx context.Context, name string, index vectordb.Index) error {
+	idx, err := entityIndex(index.WithDefaults())
+	if err != nil {
+		return fmt.Errorf("new index: %s", err)
+	}
+	oldIdxs, err := s.client.DescribeIndex(ctx, name, vectorColName)
+	if err != nil {
+		return fmt.Errorf("describe index: %s", err)
+	}
+	// The index of a loaded collection cannot be dropped.
+	if err := s.client.ReleaseCollection(ctx, name); err != nil {
+		return fmt.Errorf("release collection: %s", err)
+	}
+	if err := s.client.DropIndex(ctx, name, vectorColName); err != nil {
+		return fmt.Errorf("drop index: %s", err)
+	}
+	if err := s.client.CreateIndex(ctx, name, vectorColName, idx, false); err != nil {
+		for _, oldIdx := range oldIdxs {
+			if err := s.client.CreateIndex(ctx, name, vectorColName, oldIdx, false); err != nil {
+				s.log.Error(err, "Failed to restore the old index", "collection", name)
+			}
+		}
+		return fmt.Errorf("create index: %s", err)
+	}
+	s.log.Info("Rebuilt index", "collection", name, "index", index)
+	return nil
+}
+
 // ListVectorStores lists collections in milvus.
 func (s *S) ListVectorStores(ctx context.Context) ([]int64, error) {
 	cs, err := s.client.ListCollections(ctx)
@@ -130,25 +210,196 @@ func (s *S) ListVectorStores(ctx context.Context) ([]int64, error) {
 
 // UpdateVectorStoreName updates a collection name in milvus.
 func (s *S) UpdateVectorStoreName(ctx context.Context, oldName, newName string) error {
-	return s.client.RenameCollection(ctx, oldName, newName)
+	if err := s.client.RenameCollection(ctx, oldName, newName); err != nil {
+		return err
+	}
+	if err := s.keywords.RenameKeywordCollection(oldName, newName); err != nil {
+		return fmt.Errorf("rename keyword collection: %s", err)
+	}
+	return nil
 }
 
 // DeleteVectorStore deletes a collection in milvus.
 func (s *S) DeleteVectorStore(ctx context.Context, name string) error {
-	return s.client.DropCollection(ctx, name)
+	if err := s.client.DropCollection(ctx, name); err != nil {
+		return err
+	}
+	if err := s.keywords.DeleteKeywordCollection(name); err != nil {
+		return fmt.Errorf("delete keyword collection: %s", err)
+	}
+	return nil
 }
 
-// InsertDocuments inserts documents into a collection in milvus.
-func (s *S) InsertDocuments(ctx context.Context, name string, files, texts []string, vectors [][]float32) error {
+// InsertDocuments inserts documents into a collection in milvus. The metadata of each
+// document is stored as JSON if the collection has the metadata column.
+//
+// parentIDs are the IDs of the parent chunks of the documents. They are nil unless the documents
+// are child chunks of the parent-child chunking strategy. chunkIndexes are the positions of the
+// documents in their files. attributes are the attributes of the file, and are stored with every document.
+func (s *S) InsertDocuments(
+	ctx context.Context,
+	name string,
+	files, texts []string,
+	metadatas []map[string]any,
+	parentIDs []string,
+	chunkIndexes []int64,
+	attributes map[string]any,
+	vectors [][]float32,
+) error {
+	maxTextLength, err := s.fieldMaxLength(ctx, name, textColName)
+	if err != nil {
+		return err
+	}
+	truncated := make([]string, len(texts))
+	for i, text := range texts {
+		if truncated[i] = truncateText(text, maxTextLength); len(truncated[i]) < len(text) {
+			s.log.Info("Truncated text longer than the limit of the collection", "collection", name, "length", len(text), "limit", maxTextLength)
+		}
+	}
+
 	vectorCol := entity.NewColumnFloatVector(vectorColName, len(vectors[0]), vectors)
 	fileCol := entity.NewColumnVarChar(fileIDColName, files)
-	textCol := entity.NewColumnVarChar(textColName, texts)
-	if _, err := s.client.Insert(ctx, name, "" /* partitionName */, vectorCol, fileCol, textCol); err != nil {
+	textCol := entity.NewColumnVarChar(textColName, truncated)
+	cols := []entity.Column{vectorCol, fileCol, textCol}
+
+	hasMetadata, err := s.hasField(ctx, name, metadataColName)
+	if err != nil {
+		return err
+	}
+	if hasMetadata {
+		// Collections created by older versions do not have the metadata column.
+		var ms [][]byte
+		for i := range texts {
+			m := map[string]any{}
+			if i < len(metadatas) && metadatas[i] != nil {
+				m = metadatas[i]
+			}
+			b, err := json.Marshal(m)
+			if err != nil {
+				return fmt.Errorf("marshal metadata: %s", err)
+			}
+			ms = append(ms, b)
+		}
+		cols = append(cols, entity.NewColumnJSONBytes(metadataColName, ms))
+	}
+
+	hasParentID, err := s.hasField(ctx, name, parentIDColName)
+	if err != nil {
 		return err
 	}
+	if hasParentID {
+		ps := make([]string, len(texts))
+		copy(ps, parentIDs)
+		cols = append(cols, entity.NewColumnVarChar(parentIDColName, ps))
+	} else if len(parentIDs) > 0 {
+		// Collections created by older versions do not have the parent ID column.
+		return fmt.Errorf("collection %s does not support parent-child chunks", name)
+	}
+
+	hasChunkIndex, err := s.hasField(ctx, name, chunkIndexColName)
+	if err != nil {
+		return err
+	}
+	if hasChunkIndex {
+		// Collections created by older versions do not have the chunk index column.
+		is := make([]int64, len(texts))
+		copy(is, chunkIndexes)
+		cols = append(cols, entity.NewColumnInt64(chunkIndexColName, is))
+	}
+
+	hasAttributes, err := s.hasField(ctx, name, attributesColName)
+	if err != nil {
+		return err
+	}
+	if hasAttributes {
+		if attributes == nil {
+			attributes = map[string]any{}
+		}
+		b, err := json.Marshal(attributes)
+		if err != nil {
+			return fmt.Errorf("marshal attributes: %s", err)
+		}
+		as := make([][]byte, len(texts))
+		for i := range as {
+			as[i] = b
+		}
+		cols = append(cols, entity.NewColumnJSONBytes(attributesColName, as))
+	} else if len(attributes) > 0 {
+		// Collections created by older versions do not have the attributes column.
+		return fmt.Errorf("collection %s does not support file attributes", name)
+	}
+
+	hasSparse, err := s.hasField(ctx, name, sparseColName)
+	if err != nil {
+		return err
+	}
+	var kfs []keywordFile
+	if hasSparse {
+		// Collections created by older versions do not have the sparse vector column.
+		var ss []entity.SparseEmbedding
+		if ss, kfs, err = s.documentSparseVectors(name, files, truncated); err != nil {
+			return fmt.Errorf("sparse vector: %s", err)
+		}
+		cols = append(cols, entity.NewColumnSparseVectors(sparseColName, ss))
+	}
+
+	if _, err := s.client.Insert(ctx, name, "" /* partitionName */, cols...); err != nil {
+		return err
+	}
+	// The statistics are updated after the documents are inserted. A file whose statistics fail to be
+	// updated is deleted and added again, and its statistics are updated only once.
+	for _, kf := range kfs {
+		if err := s.keywords.AddKeywordFile(kf.file, kf.documentFrequencies); err != nil {
+			return fmt.Errorf("add keyword file: %s", err)
+		}
+	}
 	return nil
 }
 
+func (s *S) hasField(ctx context.Context, collectionName, fieldName string) (bool, error) {
+	c, err := s.client.DescribeCollection(ctx, collectionName)
+	if err != nil {
+		return false, fmt.Errorf("describe collection: %s", err)
+	}
+	for _, f := range c.Schema.Fields {
+		if f.Name == fieldName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fieldMaxLength returns the maximum length in bytes of a VarChar field of the collection.
+func (s *S) fieldMaxLength(ctx context.Context, collectionName, fieldName string) (int, error) {
+	c, err := s.client.DescribeCollection(ctx, collectionName)
+	if err != nil {
+		return 0, fmt.Errorf("describe collection: %s", err)
+	}
+	for _, f := range c.Schema.Fields {
+		if f.Name != fieldName {
+			continue
+		}
+		n, err := strconv.Atoi(f.TypeParams[entity.TypeParamMaxLength])
+		if err != nil {
+			return 0, fmt.Errorf("invalid max length of field %s: %s", fieldName, err)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("field %s not found", fieldName)
+}
+
+// truncateText truncates the text to at most maxBytes bytes without splitting a UTF-8 character.
+func truncateText(text string, maxBytes int) string {
+	if len(text) <= maxBytes {
+		return text
+	}
+	i := maxBytes
+	for i > 0 && !utf8.RuneStart(text[i]) {
+		i--
+	}
+	return text[:i]
+}
+
 // DeleteDocuments deletes documents from a collection in milvus by fileID.
 func (s *S) DeleteDocuments(ctx context.Context, collectionName, fileID string) error {
 	if err := s.client.LoadCollection(ctx, collectionName, false); err != nil {
@@ -161,11 +412,255 @@ func (s *S) DeleteDocuments(ctx context.Context, collectionName, fileID string)
 	}()
 
 	expr := fmt.Sprintf("%s like \"%s\"", fileIDColName, fileID)
-	return s.client.Delete(ctx, collectionName, "" /* partitionName */, expr)
+	if err := s.client.Delete(ctx, collectionName, "" /* partitionName */, expr); err != nil {
+		return err
+	}
+	if err := s.keywords.DeleteKeywordFile(collectionName, fileID); err != nil {
+		return fmt.Errorf("delete keyword file: %s", err)
+	}
+	return nil
+}
+
+// UpdateAttributes replaces the attributes of the documents of the file. The documents are inserted again
+// with their vectors, so the file is not embedded again, and then the old documents are deleted. Milvus
+// cannot do this atomically, so the documents are updated in batches and a failed update can be retried:
+// the retry only deletes the old documents of the chunks that have already been inserted again.
+func (s *S) UpdateAttributes(ctx context.Context, collectionName, fileID string, attributes map[string]any) error {
+	c, err := s.client.DescribeCollection(ctx, collectionName)
+	if err != nil {
+		return fmt.Errorf("describe collection: %s", err)
+	}
+	var fields []string
+	var hasAttributes bool
+	for _, f := range c.Schema.Fields {
+		fields = append(fields, f.Name)
+		if f.Name == attributesColName {
+			hasAttributes = true
+		}
+	}
+	if !hasAttributes {
+		// Collections created by older versions do not have the attributes column.
+		return fmt.Errorf("collection %s does not support file attributes", collectionName)
+	}
+
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	b, err := json.Marshal(attributes)
+	if err != nil {
+		return fmt.Errorf("marshal attributes: %s", err)
+	}
+	// Compare the attributes as they are decoded from the stored JSON.
+	var want map[string]any
+	if err := json.Unmarshal(b, &want); err != nil {
+		return fmt.Errorf("unmarshal attributes: %s", err)
+	}
+
+	if err := s.client.LoadCollection(ctx, collectionName, false); err != nil {
+		return fmt.Errorf("load collection: %s", err)
+	}
+	defer func() {
+		if err := s.client.ReleaseCollection(ctx, collectionName); err != nil {
+			s.log.Error(err, "Failed to release collection")
+		}
+	}()
+
+	// Read all the documents before inserting new ones as the new ones match the same expression.
+	pages, err := s.queryFileDocuments(ctx, collectionName, fileID, fields)
+	if err != nil {
+		return err
+	}
+
+	// updated records the chunks that have a document with the new attributes. A previous update might have
+	// inserted them and failed before it deleted the old documents.
+	updated := map[int64]bool{}
+	for _, rs := range pages {
+		for i := 0; i < rs.GetColumn(primaryKeyColName).Len(); i++ {
+			ok, err := hasAttributesAt(rs, i, want)
+			if err != nil {
+				return err
+			}
+			if ok {
+				ci, err := rs.GetColumn(chunkIndexColName).GetAsInt64(i)
+				if err != nil {
+					return fmt.Errorf("get chunk index: %s", err)
+				}
+				updated[ci] = true
+			}
+		}
+	}
+
+	for _, rs := range pages {
+		var insertRows, deleteRows []int
+		for i := 0; i < rs.GetColumn(primaryKeyColName).Len(); i++ {
+			ok, err := hasAttributesAt(rs, i, want)
+			if err != nil {
+				return err
+			}
+			if ok {
+				continue
+			}
+			deleteRows = append(deleteRows, i)
+			ci, err := rs.GetColumn(chunkIndexColName).GetAsInt64(i)
+			if err != nil {
+				return fmt.Errorf("get chunk index: %s", err)
+			}
+			if !updated[ci] {
+				insertRows = append(insertRows, i)
+				updated[ci] = true
+			}
+		}
+
+		// Insert the documents before the old ones are deleted so that a failure does not lose documents.
+		if len(insertRows) > 0 {
+			as := make([][]byte, len(insertRows))
+			for i := range as {
+				as[i] = b
+			}
+			cols := []entity.Column{entity.NewColumnJSONBytes(attributesColName, as)}
+			for _, col := range rs {
+				switch col.Name() {
+				case primaryKeyColName, attributesColName:
+					// The primary key is generated again.
+				default:
+					sc, err := selectRows(col, insertRows)
+					if err != nil {
+						return err
+					}
+					cols = append(cols, sc)
+				}
+			}
+			if _, err := s.client.Insert(ctx, collectionName, "" /* partitionName */, cols...); err != nil {
+				return fmt.Errorf("insert documents: %s", err)
+			}
+		}
+		if len(deleteRows) > 0 {
+			pks, err := selectRows(rs.GetColumn(primaryKeyColName), deleteRows)
+			if err != nil {
+				return err
+			}
+			if err := s.client.DeleteByPks(ctx, collectionName, "" /* partitionName */, pks); err != nil {
+				return fmt.Errorf("delete documents: %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// queryFileDocuments returns the fields of all documents of the file in pages. The pages are read in the
+// order of the primary keys as offsets are limited by the maximum number of results of a query.
+func (s *S) queryFileDocuments(ctx context.Context, collectionName, fileID string, fields []string) ([]client.ResultSet, error) {
+	var pages []client.ResultSet
+	var last int64
+	for first := true; ; first = false {
+		expr := fmt.Sprintf("%s == %q", fileIDColName, fileID)
+		if !first {
+			expr += fmt.Sprintf(" && %s > %d", primaryKeyColName, last)
+		}
+		rs, err := s.client.Query(
+			ctx,
+			collectionName,
+			nil, /* partitions */
+			expr,
+			fields,
+			client.WithLimit(updateBatchSize),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("query documents: %s", err)
+		}
+		ids := rs.GetColumn(primaryKeyColName)
+		if ids == nil || ids.Len() == 0 {
+			break
+		}
+		pages = append(pages, rs)
+		for i := 0; i < ids.Len(); i++ {
+			id, err := ids.GetAsInt64(i)
+			if err != nil {
+				return nil, fmt.Errorf("get primary key: %s", err)
+			}
+			last = max(last, id)
+		}
+		if ids.Len() < updateBatchSize {
+			break
+		}
+	}
+	return pages, nil
 }
 
-// Search searches for the documents with similar vectors in milvus. The texts of the matched documents are returned.
-func (s *S) Search(ctx context.Context, collectionName string, vectors []float32, numDocuments int) ([]string, error) {
+// hasAttributesAt returns true if the document at the row of the result set has the attributes.
+func hasAttributesAt(rs client.ResultSet, row int, attributes map[string]any) (bool, error) {
+	b, err := rs.GetColumn(attributesColName).Get(row)
+	if err != nil {
+		return false, fmt.Errorf("get attributes: %s", err)
+	}
+	var got map[string]any
+	if bs, ok := b.([]byte); ok && len(bs) > 0 {
+		if err := json.Unmarshal(bs, &got); err != nil {
+			return false, fmt.Errorf("unmarshal attributes: %s", err)
+		}
+	}
+	if got == nil {
+		got = map[string]any{}
+	}
+	return reflect.DeepEqual(got, attributes), nil
+}
+
+// selectRows returns a column that has the values of the column at the rows.
+func selectRows(col entity.Column, rows []int) (entity.Column, error) {
+	var c entity.Column
+	switch col := col.(type) {
+	case *entity.ColumnInt64:
+		c = entity.NewColumnInt64(col.Name(), nil)
+	case *entity.ColumnVarChar:
+		c = entity.NewColumnVarChar(col.Name(), nil)
+	case *entity.ColumnJSONBytes:
+		c = entity.NewColumnJSONBytes(col.Name(), nil)
+	case *entity.ColumnFloatVector:
+		c = entity.NewColumnFloatVector(col.Name(), col.Dim(), nil)
+	case *entity.ColumnSparseFloatVector:
+		c = entity.NewColumnSparseVectors(col.Name(), nil)
+	default:
+		return nil, fmt.Errorf("unsupported column %s of type %s", col.Name(), col.Type())
+	}
+	for _, r := range rows {
+		v, err := col.Get(r)
+		if err != nil {
+			return nil, fmt.Errorf("get %s: %s", col.Name(), err)
+		}
+		if err := c.AppendValue(v); err != nil {
+			return nil, fmt.Errorf("append %s: %s", col.Name(), err)
+		}
+	}
+	return c, nil
+}
+
+// Search searches for the documents with similar vectors in milvus. The matched documents are returned
+// in the order of similarity. The index must be the one that the collection is indexed with.
+// Only the documents that match the filter are returned if it is not nil. The vectors of the documents
+// are also returned if withVectors is true.
+func (s *S) Search(
+	ctx context.Context,
+	collectionName string,
+	index vectordb.Index,
+	vectors []float32,
+	numDocuments int,
+	filter *vectordb.Filter,
+	withVectors bool,
+) ([]vectordb.Document, error) {
+	index = index.WithDefaults()
+	mt, err := entityMetricType(index.MetricType)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := searchParam(index, numDocuments)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := s.searchExpr(ctx, collectionName, filter)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := s.client.LoadCollection(ctx, collectionName, false); err != nil {
 		return nil, fmt.Errorf("load collection: %s", err)
 	}
@@ -175,39 +670,188 @@ func (s *S) Search(ctx context.Context, collectionName string, vectors []float32
 		}
 	}()
 
-	sp, err := entity.NewIndexIvfFlatSearchParam(defaultIvfFlatSearchParam)
+	outputFields, err := s.outputFields(ctx, collectionName)
 	if err != nil {
 		return nil, err
 	}
+	if withVectors {
+		outputFields = append(outputFields, vectorColName)
+	}
 
 	vs := []entity.Vector{entity.FloatVector(vectors)}
 	results, err := s.client.Search(
 		ctx,
 		collectionName,
 		nil, /* partitions */
-		"",  /* expr */
-		[]string{primaryKeyColName, fileIDColName, textColName},
+		expr,
+		outputFields,
 		vs,
 		vectorColName,
-		defaultMetricType,
+		mt,
 		numDocuments,
 		sp,
 	)
 	if err != nil {
 		return nil, err
 	}
+	return toSearchDocuments(results, func(d *vectordb.Document, score float32) {
+		d.Distance = score
+		d.Score = similarity(mt, score)
+	})
+}
 
-	var res []string
+// searchExpr returns the expression that matches the documents that match the filter.
+func (s *S) searchExpr(ctx context.Context, collectionName string, filter *vectordb.Filter) (string, error) {
+	if filter == nil {
+		return "", nil
+	}
+	hasAttributes, err := s.hasField(ctx, collectionName, attributesColName)
+	if err != nil {
+		return "", err
+	}
+	if !hasAttributes {
+		// Collections created by older versions do not have the attributes column.
+		return "", fmt.Errorf("collection %s does not support filters", collectionName)
+	}
+	expr, err := filterExpr(filter)
+	if err != nil {
+		return "", fmt.Errorf("filter: %s", err)
+	}
+	return expr, nil
+}
+
+// toSearchDocuments converts the search results to documents. setScore sets the score of each document.
+func toSearchDocuments(results []client.SearchResult, setScore func(d *vectordb.Document, score float32)) ([]vectordb.Document, error) {
+	var res []vectordb.Document
 	for _, r := range results {
 		// TODO(guangrui): Investigate the case when ResultCount is 0.
 		if r.ResultCount == 0 {
 			continue
 		}
-		texts, ok := r.Fields.GetColumn(textColName).(*entity.ColumnVarChar)
-		if !ok {
-			return nil, fmt.Errorf("%s column missing", textColName)
+		docs, err := toDocuments(r.Fields)
+		if err != nil {
+			return nil, err
 		}
-		res = append(res, texts.Data()...)
+		for i := range docs {
+			if i < len(r.Scores) {
+				setScore(&docs[i], r.Scores[i])
+			}
+		}
+		res = append(res, docs...)
 	}
 	return res, nil
 }
+
+// ListDocumentsByChunkRanges lists the documents in the given ranges of chunks. The documents are
+// returned in no particular order. Nothing is returned if the collection does not have the chunk
+// index column.
+func (s *S) ListDocumentsByChunkRanges(ctx context.Context, collectionName string, ranges []vectordb.ChunkRange) ([]vectordb.Document, error) {
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+	hasChunkIndex, err := s.hasField(ctx, collectionName, chunkIndexColName)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChunkIndex {
+		return nil, nil
+	}
+
+	if err := s.client.LoadCollection(ctx, collectionName, false); err != nil {
+		return nil, fmt.Errorf("load collection: %s", err)
+	}
+	defer func() {
+		if err := s.client.ReleaseCollection(ctx, collectionName); err != nil {
+			s.log.Error(err, "Failed to release collection")
+		}
+	}()
+
+	outputFields, err := s.outputFields(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var exprs []string
+	for _, r := range ranges {
+		exprs = append(exprs, fmt.Sprintf("(%s == %q && %s >= %d && %s <= %d)", fileIDColName, r.FileID, chunkIndexColName, r.Start, chunkIndexColName, r.End))
+	}
+	rs, err := s.client.Query(
+		ctx,
+		collectionName,
+		nil, /* partitions */
+		strings.Join(exprs, " || "),
+		outputFields,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return toDocuments(rs)
+}
+
+// outputFields returns the fields of the collection that are converted to documents.
+func (s *S) outputFields(ctx context.Context, collectionName string) ([]string, error) {
+	c, err := s.client.DescribeCollection(ctx, collectionName)
+	if err != nil {
+		return nil, fmt.Errorf("describe collection: %s", err)
+	}
+	fields := []string{primaryKeyColName, fileIDColName, textColName}
+	for _, f := range c.Schema.Fields {
+		switch f.Name {
+		case metadataColName, parentIDColName, chunkIndexColName, attributesColName:
+			// Collections created by older versions do not have these columns.
+			fields = append(fields, f.Name)
+		}
+	}
+	return fields, nil
+}
+
+func toDocuments(rs client.ResultSet) ([]vectordb.Document, error) {
+	ids, ok := rs.GetColumn(primaryKeyColName).(*entity.ColumnInt64)
+	if !ok {
+		return nil, fmt.Errorf("%s column missing", primaryKeyColName)
+	}
+	fileIDs, ok := rs.GetColumn(fileIDColName).(*entity.ColumnVarChar)
+	if !ok {
+		return nil, fmt.Errorf("%s column missing", fileIDColName)
+	}
+	texts, ok := rs.GetColumn(textColName).(*entity.ColumnVarChar)
+	if !ok {
+		return nil, fmt.Errorf("%s column missing", textColName)
+	}
+	metadatas, _ := rs.GetColumn(metadataColName).(*entity.ColumnJSONBytes)
+	parentIDs, _ := rs.GetColumn(parentIDColName).(*entity.ColumnVarChar)
+	chunkIndexes, _ := rs.GetColumn(chunkIndexColName).(*entity.ColumnInt64)
+	attributes, _ := rs.GetColumn(attributesColName).(*entity.ColumnJSONBytes)
+	vectors, _ := rs.GetColumn(vectorColName).(*entity.ColumnFloatVector)
+
+	var docs []vectordb.Document
+	for i, text := range texts.Data() {
+		d := vectordb.Document{
+			ID:         ids.Data()[i],
+			FileID:     fileIDs.Data()[i],
+			Text:       text,
+			ChunkIndex: -1,
+		}
+		if metadatas != nil {
+			if err := json.Unmarshal(metadatas.Data()[i], &d.Metadata); err != nil {
+				return nil, fmt.Errorf("unmarshal metadata: %s", err)
+			}
+		}
+		if parentIDs != nil {
+			d.ParentID = parentIDs.Data()[i]
+		}
+		if chunkIndexes != nil {
+			d.ChunkIndex = chunkIndexes.Data()[i]
+		}
+		if attributes != nil {
+			if err := json.Unmarshal(attributes.Data()[i], &d.Attributes); err != nil {
+				return nil, fmt.Errorf("unmarshal attributes: %s", err)
+			}
+		}
+		if vectors != nil {
+			d.Vector = vectors.Data()[i]
+		}
+		docs = append(docs, d)
+	}
+	return docs, nil
+}
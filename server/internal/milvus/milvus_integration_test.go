@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/llmariner/common/pkg/db"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -29,7 +30,7 @@ func TestMilvusCreateListUpdateDeleteVectorStores(t *testing.T) {
 	preExist, err := s.ListVectorStores(ctx)
 	assert.NoError(t, err)
 
-	_, err = s.CreateVectorStore(ctx, collectionName, dimensions)
+	_, err = s.CreateVectorStore(ctx, collectionName, dimensions, vectordb.Index{})
 	assert.NoError(t, err)
 
 	vss, err := s.ListVectorStores(ctx)
@@ -72,28 +73,61 @@ func TestInsertSearchDeleteDocuments(t *testing.T) {
 	s, err := New(ctx, cfg)
 	assert.NoError(t, err)
 
-	_, err = s.CreateVectorStore(ctx, collectionName, dimensions)
+	_, err = s.CreateVectorStore(ctx, collectionName, dimensions, vectordb.Index{})
 	assert.NoError(t, err)
 
-	err = s.InsertDocuments(ctx, collectionName, fileIDs, texts, vectors)
+	err = s.InsertDocuments(ctx, collectionName, fileIDs[:2], texts[:2], nil, nil, []int64{0, 1}, map[string]any{"year": 2024.0}, vectors[:2])
 	assert.NoError(t, err)
+	err = s.InsertDocuments(ctx, collectionName, fileIDs[2:], texts[2:], nil, nil, []int64{0}, map[string]any{"year": 2020.0}, vectors[2:])
+	assert.NoError(t, err)
+
+	got, err := s.Search(ctx, collectionName, vectordb.Index{}, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, 1, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "world", got[0].Text)
+	assert.Equal(t, int64(1), got[0].ChunkIndex)
+	assert.Equal(t, map[string]any{"year": 2024.0}, got[0].Attributes)
+	assert.Len(t, got[0].Vector, dimensions)
+	assert.Greater(t, got[0].Score, float32(0))
 
-	got, err := s.Search(ctx, collectionName, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, 1)
+	got, err = s.Search(ctx, collectionName, vectordb.Index{}, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, 10, &vectordb.Filter{Type: vectordb.FilterTypeLt, Key: "year", Value: 2022.0}, false)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(got))
-	assert.Equal(t, []string{"world"}, got)
+	assert.Equal(t, "bye", got[0].Text)
+
+	got, err = s.HybridSearch(ctx, collectionName, vectordb.Index{}, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, "bye", 3, nil, vectordb.Fusion{Type: vectordb.FusionTypeRRF}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(got))
+	assert.Greater(t, got[0].Score, float32(0))
+
+	err = s.UpdateAttributes(ctx, collectionName, "file-002", map[string]any{"year": 2025.0})
+	assert.NoError(t, err)
+	got, err = s.Search(ctx, collectionName, vectordb.Index{}, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, 10, &vectordb.Filter{Type: vectordb.FilterTypeLt, Key: "year", Value: 2022.0}, false)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	got, err = s.ListDocumentsByChunkRanges(ctx, collectionName, []vectordb.ChunkRange{{FileID: "file-001", Start: 0, End: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(got))
 
 	err = s.DeleteDocuments(ctx, collectionName, "file-001")
 	assert.NoError(t, err)
 
-	got, err = s.Search(ctx, collectionName, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, 10)
+	got, err = s.Search(ctx, collectionName, vectordb.Index{}, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, 10, nil, false)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(got))
-	assert.Equal(t, []string{"bye"}, got)
+	assert.Equal(t, "bye", got[0].Text)
 
 	err = s.DeleteDocuments(ctx, collectionName, "file-unknown")
 	assert.NoError(t, err)
 
+	hnsw := vectordb.Index{Type: vectordb.IndexTypeHNSW}
+	err = s.RebuildIndex(ctx, collectionName, hnsw)
+	assert.NoError(t, err)
+	got, err = s.Search(ctx, collectionName, hnsw, []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}, 10, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
 	err = s.DeleteVectorStore(ctx, collectionName)
 	assert.NoError(t, err)
 }
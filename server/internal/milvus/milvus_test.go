@@ -0,0 +1,66 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateText(t *testing.T) {
+	tcs := []struct {
+		text     string
+		maxBytes int
+		want     string
+	}{
+		{text: "hello", maxBytes: 10, want: "hello"},
+		{text: "hello", maxBytes: 5, want: "hello"},
+		{text: "hello", maxBytes: 3, want: "hel"},
+		// "あ" is 3 bytes in UTF-8 and is not split.
+		{text: "aあい", maxBytes: 5, want: "aあ"},
+		{text: "aあい", maxBytes: 3, want: "a"},
+	}
+	for _, tc := range tcs {
+		assert.Equal(t, tc.want, truncateText(tc.text, tc.maxBytes), "%q %d", tc.text, tc.maxBytes)
+	}
+}
+
+func TestSelectRows(t *testing.T) {
+	cols := []entity.Column{
+		entity.NewColumnInt64("i", []int64{1, 2, 3}),
+		entity.NewColumnVarChar("s", []string{"a", "b", "c"}),
+		entity.NewColumnJSONBytes("j", [][]byte{[]byte(`1`), []byte(`2`), []byte(`3`)}),
+		entity.NewColumnFloatVector("v", 2, [][]float32{{1, 1}, {2, 2}, {3, 3}}),
+	}
+	want := []entity.Column{
+		entity.NewColumnInt64("i", []int64{1, 3}),
+		entity.NewColumnVarChar("s", []string{"a", "c"}),
+		entity.NewColumnJSONBytes("j", [][]byte{[]byte(`1`), []byte(`3`)}),
+		entity.NewColumnFloatVector("v", 2, [][]float32{{1, 1}, {3, 3}}),
+	}
+	for i, col := range cols {
+		got, err := selectRows(col, []int{0, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, want[i], got)
+	}
+}
+
+func TestHasAttributesAt(t *testing.T) {
+	rs := client.ResultSet{
+		entity.NewColumnJSONBytes(attributesColName, [][]byte{
+			[]byte(`{"author":"alice","year":2024}`),
+			[]byte(`{"author":"bob"}`),
+			[]byte(`{}`),
+		}),
+	}
+	want := map[string]any{"author": "alice", "year": 2024.0}
+	for i, w := range []bool{true, false, false} {
+		got, err := hasAttributesAt(rs, i, want)
+		assert.NoError(t, err)
+		assert.Equal(t, w, got)
+	}
+	got, err := hasAttributesAt(rs, 2, map[string]any{})
+	assert.NoError(t, err)
+	assert.True(t, got)
+}
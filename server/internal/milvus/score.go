@@ -0,0 +1,36 @@
+package milvus
+
+import (
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+)
+
+// similarity converts a score returned by a vector search with the metric to a similarity between 0 and 1.
+// Higher is more similar.
+func similarity(metric entity.MetricType, score float32) float32 {
+	switch metric {
+	case entity.IP, entity.COSINE:
+		// The score is the inner product or the cosine similarity, which is between -1 and 1 for
+		// normalized vectors.
+		return clamp((1 + score) / 2)
+	default:
+		// The score is the distance between the vectors.
+		return 1 / (1 + max(score, 0))
+	}
+}
+
+// normalizeFusionScore converts a merged score of hybrid search to a score between 0 and 1. The score of
+// reciprocal rank fusion depends only on the ranks of the document, so it is not a measure of relevance.
+func normalizeFusionScore(fusion vectordb.Fusion, score float32) float32 {
+	if fusion.Type == vectordb.FusionTypeWeighted {
+		// The weighted ranker normalizes the score of each search to between 0 and 1.
+		return clamp(score)
+	}
+	// The maximum score of reciprocal rank fusion is the sum of the reciprocal ranks of the top
+	// documents of the vector search and the keyword search.
+	return clamp(score * (defaultRRFK + 1) / 2)
+}
+
+func clamp(score float32) float32 {
+	return min(max(score, 0), 1)
+}
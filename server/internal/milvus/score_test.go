@@ -0,0 +1,62 @@
+package milvus
+
+import (
+	"testing"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarity(t *testing.T) {
+	tcs := []struct {
+		name   string
+		metric entity.MetricType
+		score  float32
+		want   float32
+	}{
+		{
+			name:   "l2 same",
+			metric: entity.L2,
+			score:  0,
+			want:   1,
+		},
+		{
+			name:   "l2",
+			metric: entity.L2,
+			score:  3,
+			want:   0.25,
+		},
+		{
+			name:   "ip",
+			metric: entity.IP,
+			score:  0.5,
+			want:   0.75,
+		},
+		{
+			name:   "cosine opposite",
+			metric: entity.COSINE,
+			score:  -1,
+			want:   0,
+		},
+		{
+			name:   "ip of unnormalized vectors",
+			metric: entity.IP,
+			score:  3,
+			want:   1,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, similarity(tc.metric, tc.score), 1e-6)
+		})
+	}
+}
+
+func TestNormalizeFusionScore(t *testing.T) {
+	// The top document of both searches has the maximum score.
+	top := float32(2.0 / (defaultRRFK + 1))
+	assert.InDelta(t, 1, normalizeFusionScore(vectordb.Fusion{Type: vectordb.FusionTypeRRF}, top), 1e-6)
+	assert.InDelta(t, 0.5, normalizeFusionScore(vectordb.Fusion{}, top/2), 1e-6)
+	assert.InDelta(t, 0.3, normalizeFusionScore(vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: 0.5}, 0.3), 1e-6)
+}
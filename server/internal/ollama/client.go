@@ -2,6 +2,7 @@ package ollama
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 
@@ -26,6 +27,18 @@ type Ollama struct {
 
 // Embed creates embeddings.
 func (o *Ollama) Embed(ctx context.Context, modelName, prompt string) ([]float32, error) {
+	// Use the same endpoint as EmbedBatch so that the query and document embeddings are
+	// generated in the same way (/api/embed normalizes embeddings while /api/embeddings does not).
+	es, err := o.EmbedBatch(ctx, modelName, []string{prompt})
+	if err != nil {
+		return nil, err
+	}
+	return es[0], nil
+}
+
+// EmbedLegacy creates embeddings with /api/embeddings, which older versions used. Unlike /api/embed, the
+// embeddings are not normalized.
+func (o *Ollama) EmbedLegacy(ctx context.Context, modelName, prompt string) ([]float32, error) {
 	req := api.EmbeddingRequest{
 		Model:  modelName,
 		Prompt: prompt,
@@ -36,13 +49,29 @@ func (o *Ollama) Embed(ctx context.Context, modelName, prompt string) ([]float32
 	}
 
 	// ollama generates embeddings as []float64, but milvus takes []float32 only, so convert []float64 to []float32.
-	var es32 []float32
-	for _, e := range resp.Embedding {
-		es32 = append(es32, float32(e))
+	es32 := make([]float32, len(resp.Embedding))
+	for i, e := range resp.Embedding {
+		es32[i] = float32(e)
 	}
 	return es32, nil
 }
 
+// EmbedBatch creates embeddings for multiple prompts in a single request.
+func (o *Ollama) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
+	req := api.EmbedRequest{
+		Model: modelName,
+		Input: prompts,
+	}
+	resp, err := o.client.Embed(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Embeddings) != len(prompts) {
+		return nil, fmt.Errorf("unexpected number of embeddings: got %d, want %d", len(resp.Embeddings), len(prompts))
+	}
+	return resp.Embeddings, nil
+}
+
 // PullModel pulls a model.
 func (o *Ollama) PullModel(ctx context.Context, modelName string) error {
 	req := api.PullRequest{
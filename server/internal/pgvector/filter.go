@@ -0,0 +1,88 @@
+package pgvector
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+)
+
+var comparisonOperators = map[vectordb.FilterType]string{
+	vectordb.FilterTypeEq:  "=",
+	vectordb.FilterTypeNe:  "<>",
+	vectordb.FilterTypeGt:  ">",
+	vectordb.FilterTypeGte: ">=",
+	vectordb.FilterTypeLt:  "<",
+	vectordb.FilterTypeLte: "<=",
+}
+
+// filterSQL translates the filter into a condition on the attributes column and its arguments. Keys and
+// values are passed as arguments. Strings and bools are compared as JSON values, and numbers are compared
+// only with attributes that are numbers.
+func filterSQL(f *vectordb.Filter) (string, []any, error) {
+	if err := f.Validate(); err != nil {
+		return "", nil, err
+	}
+	return filterCondition(f)
+}
+
+func filterCondition(f *vectordb.Filter) (string, []any, error) {
+	switch f.Type {
+	case vectordb.FilterTypeAnd, vectordb.FilterTypeOr:
+		var (
+			conds []string
+			args  []any
+		)
+		for _, sf := range f.Filters {
+			c, as, err := filterCondition(sf)
+			if err != nil {
+				return "", nil, err
+			}
+			conds = append(conds, "("+c+")")
+			args = append(args, as...)
+		}
+		op := " AND "
+		if f.Type == vectordb.FilterTypeOr {
+			op = " OR "
+		}
+		return strings.Join(conds, op), args, nil
+	case vectordb.FilterTypeIn:
+		args := []any{f.Key}
+		var ps []string
+		for _, v := range f.Value.([]any) {
+			j, err := jsonValue(v)
+			if err != nil {
+				return "", nil, err
+			}
+			ps = append(ps, "?::jsonb")
+			args = append(args, j)
+		}
+		return fmt.Sprintf("attributes -> ?::text IN (%s)", strings.Join(ps, ", ")), args, nil
+	}
+
+	op, ok := comparisonOperators[f.Type]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported filter type %q", f.Type)
+	}
+	if f.Type == vectordb.FilterTypeEq || f.Type == vectordb.FilterTypeNe {
+		j, err := jsonValue(f.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("attributes -> ?::text %s ?::jsonb", op), []any{f.Key, j}, nil
+	}
+	// The attribute is NULL unless it is a number so that the cast does not fail.
+	return fmt.Sprintf(
+		"(CASE WHEN jsonb_typeof(attributes -> ?::text) = 'number' THEN (attributes ->> ?::text)::float8 END) %s ?",
+		op,
+	), []any{f.Key, f.Key, f.Value}, nil
+}
+
+func jsonValue(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("marshal filter value: %s", err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,83 @@
+package pgvector
+
+import (
+	"testing"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterSQL(t *testing.T) {
+	tcs := []struct {
+		name     string
+		filter   *vectordb.Filter
+		want     string
+		wantArgs []any
+		wantErr  bool
+	}{
+		{
+			name:     "eq string",
+			filter:   &vectordb.Filter{Type: vectordb.FilterTypeEq, Key: "author", Value: "alice"},
+			want:     "attributes -> ?::text = ?::jsonb",
+			wantArgs: []any{"author", `"alice"`},
+		},
+		{
+			name:     "ne bool",
+			filter:   &vectordb.Filter{Type: vectordb.FilterTypeNe, Key: "draft", Value: true},
+			want:     "attributes -> ?::text <> ?::jsonb",
+			wantArgs: []any{"draft", "true"},
+		},
+		{
+			name:     "gte number",
+			filter:   &vectordb.Filter{Type: vectordb.FilterTypeGte, Key: "year", Value: 2024.0},
+			want:     "(CASE WHEN jsonb_typeof(attributes -> ?::text) = 'number' THEN (attributes ->> ?::text)::float8 END) >= ?",
+			wantArgs: []any{"year", "year", 2024.0},
+		},
+		{
+			name:     "in",
+			filter:   &vectordb.Filter{Type: vectordb.FilterTypeIn, Key: "lang", Value: []any{"en", "ja"}},
+			want:     "attributes -> ?::text IN (?::jsonb, ?::jsonb)",
+			wantArgs: []any{"lang", `"en"`, `"ja"`},
+		},
+		{
+			name: "compound",
+			filter: &vectordb.Filter{
+				Type: vectordb.FilterTypeAnd,
+				Filters: []*vectordb.Filter{
+					{Type: vectordb.FilterTypeEq, Key: "author", Value: "alice"},
+					{
+						Type: vectordb.FilterTypeOr,
+						Filters: []*vectordb.Filter{
+							{Type: vectordb.FilterTypeEq, Key: "lang", Value: "en"},
+							{Type: vectordb.FilterTypeEq, Key: "lang", Value: "ja"},
+						},
+					},
+				},
+			},
+			want:     "(attributes -> ?::text = ?::jsonb) AND ((attributes -> ?::text = ?::jsonb) OR (attributes -> ?::text = ?::jsonb))",
+			wantArgs: []any{"author", `"alice"`, "lang", `"en"`, "lang", `"ja"`},
+		},
+		{
+			name:    "unsupported type",
+			filter:  &vectordb.Filter{Type: "like", Key: "author", Value: "a%"},
+			wantErr: true,
+		},
+		{
+			name:    "string range",
+			filter:  &vectordb.Filter{Type: vectordb.FilterTypeLt, Key: "author", Value: "bob"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, args, err := filterSQL(tc.filter)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantArgs, args)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package pgvector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+)
+
+const (
+	// maxIndexedDimensions is the maximum dimensions of vectors that pgvector can index.
+	maxIndexedDimensions = 2000
+	// maxIVFFlatLists is the maximum number of lists of an IVFFlat index.
+	maxIVFFlatLists = 32768
+	// maxHNSWM and maxHNSWEFConstruction are the maximum parameters of an HNSW index.
+	maxHNSWM              = 100
+	maxHNSWEFConstruction = 1000
+	// maxHNSWEFSearch is the maximum number of candidates that are considered when HNSW is searched.
+	maxHNSWEFSearch = 1000
+)
+
+// ValidateIndex returns an error if the index cannot be built for vectors of the dimensions. pgvector
+// supports flat, ivf_flat and hnsw. An ivf_flat index is clustered by the vectors that exist when it is
+// built, so it should be rebuilt after the vector store is filled.
+func (s *S) ValidateIndex(index vectordb.Index, dimensions int) error {
+	if err := index.Validate(dimensions); err != nil {
+		return err
+	}
+	index = index.WithDefaults()
+	switch index.Type {
+	case vectordb.IndexTypeFlat:
+		return nil
+	case vectordb.IndexTypeIVFFlat:
+		if index.NList > maxIVFFlatLists {
+			return fmt.Errorf("nlist must not be greater than %d", maxIVFFlatLists)
+		}
+	case vectordb.IndexTypeHNSW:
+		if index.M < 2 || index.M > maxHNSWM {
+			return fmt.Errorf("m must be between 2 and %d", maxHNSWM)
+		}
+		if index.EFConstruction < 2*index.M || index.EFConstruction > maxHNSWEFConstruction {
+			return fmt.Errorf("ef_construction must be between 2 * m and %d", maxHNSWEFConstruction)
+		}
+		if index.EF > maxHNSWEFSearch {
+			return fmt.Errorf("ef must not be greater than %d", maxHNSWEFSearch)
+		}
+	default:
+		return fmt.Errorf("unsupported index type %q", index.Type)
+	}
+	if dimensions > maxIndexedDimensions {
+		return fmt.Errorf("%s index supports at most %d dimensions", index.Type, maxIndexedDimensions)
+	}
+	return nil
+}
+
+// createIndexStatement returns the statement that creates the index of the vectors of the collection with
+// the name. The index is built without blocking writes if concurrently is true. It returns an empty string
+// for the flat index as the vectors are scanned without an index.
+func createIndexStatement(c *collection, name string, index vectordb.Index, concurrently bool) string {
+	create := "CREATE INDEX"
+	if concurrently {
+		create = "CREATE INDEX CONCURRENTLY"
+	}
+	ops := operatorClass(index.MetricType)
+	switch index.Type {
+	case vectordb.IndexTypeIVFFlat:
+		return fmt.Sprintf(
+			"%s %s ON %s USING ivfflat (vector %s) WITH (lists = %d)",
+			create, name, c.documentTable(), ops, index.NList,
+		)
+	case vectordb.IndexTypeHNSW:
+		return fmt.Sprintf(
+			"%s %s ON %s USING hnsw (vector %s) WITH (m = %d, ef_construction = %d)",
+			create, name, c.documentTable(), ops, index.M, index.EFConstruction,
+		)
+	default:
+		return ""
+	}
+}
+
+// searchSettingStatement returns the statement that sets the parameter of a search with the index for the
+// number of documents. HNSW considers at least as many candidates as the number of documents up to
+// maxHNSWEFSearch, which limits the number of documents that a search returns.
+func searchSettingStatement(index vectordb.Index, numDocuments int) string {
+	switch index.Type {
+	case vectordb.IndexTypeIVFFlat:
+		return fmt.Sprintf("SET LOCAL ivfflat.probes = %d", index.NProbe)
+	case vectordb.IndexTypeHNSW:
+		return fmt.Sprintf("SET LOCAL hnsw.ef_search = %d", min(max(index.EF, numDocuments), maxHNSWEFSearch))
+	default:
+		return ""
+	}
+}
+
+func operatorClass(metric vectordb.MetricType) string {
+	switch metric {
+	case vectordb.MetricTypeIP:
+		return "vector_ip_ops"
+	case vectordb.MetricTypeCosine:
+		return "vector_cosine_ops"
+	default:
+		return "vector_l2_ops"
+	}
+}
+
+// distanceOperator returns the operator that computes the distance between vectors for the metric. The
+// operator must match the operator class of the index for the index to be used.
+func distanceOperator(metric vectordb.MetricType) (string, error) {
+	switch metric {
+	case vectordb.MetricTypeL2, "":
+		return "<->", nil
+	case vectordb.MetricTypeIP:
+		return "<#>", nil
+	case vectordb.MetricTypeCosine:
+		return "<=>", nil
+	default:
+		return "", fmt.Errorf("unsupported metric type %q", metric)
+	}
+}
+
+// similarity converts a distance returned by the operator of the metric to a similarity between 0 and 1.
+// Higher is more similar.
+func similarity(metric vectordb.MetricType, distance float32) float32 {
+	switch metric {
+	case vectordb.MetricTypeIP:
+		// The distance is the negative inner product, which is between -1 and 1 for normalized vectors.
+		return clamp((1 - distance) / 2)
+	case vectordb.MetricTypeCosine:
+		// The distance is 1 - the cosine similarity.
+		return clamp(1 - distance/2)
+	default:
+		return 1 / (1 + max(distance, 0))
+	}
+}
+
+func clamp(score float32) float32 {
+	return min(max(score, 0), 1)
+}
+
+// vectorLiteral returns the text representation of the vector.
+func vectorLiteral(v []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// parseVector parses the text representation of a vector.
+func parseVector(s string) ([]float32, error) {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+	if s == "" {
+		return nil, nil
+	}
+	var v []float32
+	for _, f := range strings.Split(s, ",") {
+		p, err := strconv.ParseFloat(f, 32)
+		if err != nil {
+			return nil, fmt.Errorf("parse vector: %s", err)
+		}
+		v = append(v, float32(p))
+	}
+	return v, nil
+}
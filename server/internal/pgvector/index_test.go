@@ -0,0 +1,207 @@
+package pgvector
+
+import (
+	"testing"
+
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIndex(t *testing.T) {
+	tcs := []struct {
+		name       string
+		index      vectordb.Index
+		dimensions int
+		wantErr    bool
+	}{
+		{
+			name:       "default",
+			index:      vectordb.Index{},
+			dimensions: 384,
+		},
+		{
+			name:       "hnsw",
+			index:      vectordb.Index{MetricType: vectordb.MetricTypeCosine, Type: vectordb.IndexTypeHNSW},
+			dimensions: 384,
+		},
+		{
+			name:       "flat with many dimensions",
+			index:      vectordb.Index{Type: vectordb.IndexTypeFlat},
+			dimensions: 3072,
+		},
+		{
+			name:       "hnsw with many dimensions",
+			index:      vectordb.Index{Type: vectordb.IndexTypeHNSW},
+			dimensions: 3072,
+			wantErr:    true,
+		},
+		{
+			name:       "too small ef construction",
+			index:      vectordb.Index{Type: vectordb.IndexTypeHNSW, M: 32, EFConstruction: 40},
+			dimensions: 384,
+			wantErr:    true,
+		},
+		{
+			name:       "too many lists",
+			index:      vectordb.Index{Type: vectordb.IndexTypeIVFFlat, NList: 100000},
+			dimensions: 384,
+			wantErr:    true,
+		},
+		{
+			name:       "ivf pq",
+			index:      vectordb.Index{Type: vectordb.IndexTypeIVFPQ},
+			dimensions: 384,
+			wantErr:    true,
+		},
+		{
+			name:       "diskann",
+			index:      vectordb.Index{Type: vectordb.IndexTypeDiskANN},
+			dimensions: 384,
+			wantErr:    true,
+		},
+	}
+	s := &S{}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := s.ValidateIndex(tc.index, tc.dimensions)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateIndexStatement(t *testing.T) {
+	c := &collection{ID: 3}
+	tcs := []struct {
+		name         string
+		index        vectordb.Index
+		concurrently bool
+		want         string
+	}{
+		{
+			name:  "ivf flat",
+			index: vectordb.Index{MetricType: vectordb.MetricTypeL2, Type: vectordb.IndexTypeIVFFlat, NList: 128},
+			want:  "CREATE INDEX pgvector_documents_3_vector_idx ON pgvector_documents_3 USING ivfflat (vector vector_l2_ops) WITH (lists = 128)",
+		},
+		{
+			name:  "hnsw",
+			index: vectordb.Index{MetricType: vectordb.MetricTypeIP, Type: vectordb.IndexTypeHNSW, M: 16, EFConstruction: 200},
+			want:  "CREATE INDEX pgvector_documents_3_vector_idx ON pgvector_documents_3 USING hnsw (vector vector_ip_ops) WITH (m = 16, ef_construction = 200)",
+		},
+		{
+			name:         "concurrently",
+			index:        vectordb.Index{MetricType: vectordb.MetricTypeL2, Type: vectordb.IndexTypeIVFFlat, NList: 128},
+			concurrently: true,
+			want:         "CREATE INDEX CONCURRENTLY pgvector_documents_3_vector_idx ON pgvector_documents_3 USING ivfflat (vector vector_l2_ops) WITH (lists = 128)",
+		},
+		{
+			name:  "flat",
+			index: vectordb.Index{MetricType: vectordb.MetricTypeCosine, Type: vectordb.IndexTypeFlat},
+			want:  "",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, createIndexStatement(c, c.vectorIndexName(), tc.index, tc.concurrently))
+		})
+	}
+}
+
+func TestSearchSettingStatement(t *testing.T) {
+	tcs := []struct {
+		name         string
+		index        vectordb.Index
+		numDocuments int
+		want         string
+	}{
+		{
+			name:         "ivf flat",
+			index:        vectordb.Index{Type: vectordb.IndexTypeIVFFlat, NProbe: 16},
+			numDocuments: 100,
+			want:         "SET LOCAL ivfflat.probes = 16",
+		},
+		{
+			name:         "hnsw",
+			index:        vectordb.Index{Type: vectordb.IndexTypeHNSW, EF: 64},
+			numDocuments: 10,
+			want:         "SET LOCAL hnsw.ef_search = 64",
+		},
+		{
+			name:         "hnsw with more documents than ef",
+			index:        vectordb.Index{Type: vectordb.IndexTypeHNSW, EF: 64},
+			numDocuments: 100,
+			want:         "SET LOCAL hnsw.ef_search = 100",
+		},
+		{
+			name:         "flat",
+			index:        vectordb.Index{Type: vectordb.IndexTypeFlat},
+			numDocuments: 10,
+			want:         "",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, searchSettingStatement(tc.index, tc.numDocuments))
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tcs := []struct {
+		name     string
+		metric   vectordb.MetricType
+		distance float32
+		want     float32
+	}{
+		{
+			name:     "l2 same vector",
+			metric:   vectordb.MetricTypeL2,
+			distance: 0,
+			want:     1,
+		},
+		{
+			name:     "l2",
+			metric:   vectordb.MetricTypeL2,
+			distance: 1,
+			want:     0.5,
+		},
+		{
+			name:     "ip",
+			metric:   vectordb.MetricTypeIP,
+			distance: -0.5,
+			want:     0.75,
+		},
+		{
+			name:     "cosine opposite vector",
+			metric:   vectordb.MetricTypeCosine,
+			distance: 2,
+			want:     0,
+		},
+		{
+			name:     "cosine",
+			metric:   vectordb.MetricTypeCosine,
+			distance: 0.5,
+			want:     0.75,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.InDelta(t, tc.want, similarity(tc.metric, tc.distance), 1e-6)
+		})
+	}
+}
+
+func TestVectorLiteral(t *testing.T) {
+	v := []float32{0.5, -1, 1e-7, 0.1}
+	l := vectorLiteral(v)
+	assert.Equal(t, "[0.5,-1,1e-07,0.1]", l)
+	got, err := parseVector(l)
+	assert.NoError(t, err)
+	assert.Equal(t, v, got)
+
+	_, err = parseVector("[0.5,x]")
+	assert.Error(t, err)
+}
@@ -0,0 +1,493 @@
+package pgvector
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/common/pkg/db"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"gorm.io/gorm"
+)
+
+const (
+	// documentTablePrefix is the prefix of the tables of the documents. Each collection has its own table
+	// named after the ID of the collection so that collection names are never put into SQL statements.
+	documentTablePrefix = "pgvector_documents_"
+	// insertBatchSize is the number of documents that are inserted by a single statement.
+	insertBatchSize = 500
+)
+
+// collection is a collection of documents. The vectors of a collection have the same dimensions.
+type collection struct {
+	ID         int64  `gorm:"primaryKey"`
+	Name       string `gorm:"uniqueIndex"`
+	Dimensions int
+}
+
+// TableName returns the name of the table of the collections.
+func (collection) TableName() string {
+	return "pgvector_collections"
+}
+
+func (c *collection) documentTable() string {
+	return fmt.Sprintf("%s%d", documentTablePrefix, c.ID)
+}
+
+func (c *collection) vectorIndexName() string {
+	return c.documentTable() + "_vector_idx"
+}
+
+// newVectorIndexName returns the name of the index that is built while the vectors are reindexed.
+func (c *collection) newVectorIndexName() string {
+	return c.documentTable() + "_vector_idx_new"
+}
+
+// S stores vectors in PostgreSQL with the pgvector extension.
+type S struct {
+	db  *gorm.DB
+	log logr.Logger
+}
+
+var _ vectordb.Client = &S{}
+
+// New connects to PostgreSQL, and creates the pgvector extension and the table of the collections if
+// they do not exist.
+func New(ctx context.Context, cfg db.Config, log logr.Logger) (*S, error) {
+	log = log.WithName("pgvector")
+
+	log.Info("Connecting to PostgreSQL", "host", cfg.Host, "port", cfg.Port, "database", cfg.Database)
+	d, err := db.OpenDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.WithContext(ctx).Exec("CREATE EXTENSION IF NOT EXISTS vector").Error; err != nil {
+		return nil, fmt.Errorf("create extension: %s", err)
+	}
+	if err := d.WithContext(ctx).AutoMigrate(&collection{}); err != nil {
+		return nil, fmt.Errorf("migrate: %s", err)
+	}
+	log.Info("Connected to PostgreSQL")
+
+	return &S{
+		db:  d,
+		log: log,
+	}, nil
+}
+
+// CreateVectorStore creates a table of documents. The vectors of the table are indexed with the index.
+func (s *S) CreateVectorStore(ctx context.Context, name string, dimensions int, index vectordb.Index) (int64, error) {
+	index = index.WithDefaults()
+	if err := s.ValidateIndex(index, dimensions); err != nil {
+		return 0, err
+	}
+
+	c := &collection{
+		Name:       name,
+		Dimensions: dimensions,
+	}
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(c).Error; err != nil {
+			return fmt.Errorf("create collection: %s", err)
+		}
+		if err := tx.Exec(createTableStatement(c)).Error; err != nil {
+			return fmt.Errorf("create table: %s", err)
+		}
+		stmt := fmt.Sprintf("CREATE INDEX %s_file_id_idx ON %s (file_id, chunk_index)", c.documentTable(), c.documentTable())
+		if err := tx.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("create file ID index: %s", err)
+		}
+		if stmt := createIndexStatement(c, c.vectorIndexName(), index, false); stmt != "" {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return fmt.Errorf("create index: %s", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	s.log.Info("Created collection", "collection", name, "id", c.ID)
+	return c.ID, nil
+}
+
+// RebuildIndex replaces the index of the vectors of a collection with a new index. The metric of the new
+// index must be the same as the metric of the old one as the stored vectors are not changed. The new index is
+// built concurrently under another name so that the old index serves searches and writes are not blocked
+// until the new index is ready. The old index is then replaced by the new one in a short transaction.
+func (s *S) RebuildIndex(ctx context.Context, name string, index vectordb.Index) error {
+	c, err := s.getCollection(ctx, name)
+	if err != nil {
+		return err
+	}
+	index = index.WithDefaults()
+	if err := s.ValidateIndex(index, c.Dimensions); err != nil {
+		return err
+	}
+	d := s.db.WithContext(ctx)
+	// A failed concurrent build leaves an invalid index behind.
+	dropNew := fmt.Sprintf("DROP INDEX CONCURRENTLY IF EXISTS %s", c.newVectorIndexName())
+	if err := d.Exec(dropNew).Error; err != nil {
+		return fmt.Errorf("drop new index: %s", err)
+	}
+	stmt := createIndexStatement(c, c.newVectorIndexName(), index, true)
+	if stmt != "" {
+		if err := d.Exec(stmt).Error; err != nil {
+			if err := s.db.Exec(dropNew).Error; err != nil {
+				s.log.Error(err, "Failed to drop the new index", "collection", name)
+			}
+			return fmt.Errorf("create index: %s", err)
+		}
+	}
+	// The old index is dropped and the new index is renamed in one transaction so that the collection is never
+	// left with only the new index. The drop is not concurrent, but it only holds its lock for a moment.
+	if err := d.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("DROP INDEX IF EXISTS %s", c.vectorIndexName())).Error; err != nil {
+			return fmt.Errorf("drop index: %s", err)
+		}
+		if stmt == "" {
+			return nil
+		}
+		if err := tx.Exec(fmt.Sprintf("ALTER INDEX %s RENAME TO %s", c.newVectorIndexName(), c.vectorIndexName())).Error; err != nil {
+			return fmt.Errorf("rename index: %s", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	s.log.Info("Rebuilt index", "collection", name, "index", index)
+	return nil
+}
+
+// ListVectorStores lists the IDs of the collections.
+func (s *S) ListVectorStores(ctx context.Context) ([]int64, error) {
+	var cs []collection
+	if err := s.db.WithContext(ctx).Find(&cs).Error; err != nil {
+		return nil, err
+	}
+	var ids []int64
+	for _, c := range cs {
+		ids = append(ids, c.ID)
+	}
+	return ids, nil
+}
+
+// UpdateVectorStoreName updates the name of a collection. The table of the documents is not renamed.
+func (s *S) UpdateVectorStoreName(ctx context.Context, oldName, newName string) error {
+	res := s.db.WithContext(ctx).Model(&collection{}).Where("name = ?", oldName).Update("name", newName)
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("collection %q not found", oldName)
+	}
+	return nil
+}
+
+// DeleteVectorStore drops the table of the documents of a collection.
+func (s *S) DeleteVectorStore(ctx context.Context, name string) error {
+	c, err := s.getCollection(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", c.documentTable())).Error; err != nil {
+			return fmt.Errorf("drop table: %s", err)
+		}
+		if err := tx.Delete(c).Error; err != nil {
+			return fmt.Errorf("delete collection: %s", err)
+		}
+		return nil
+	})
+}
+
+// InsertDocuments inserts documents into a collection.
+func (s *S) InsertDocuments(
+	ctx context.Context,
+	name string,
+	files, texts []string,
+	metadatas []map[string]any,
+	parentIDs []string,
+	chunkIndexes []int64,
+	attributes map[string]any,
+	vectors [][]float32,
+) error {
+	c, err := s.getCollection(ctx, name)
+	if err != nil {
+		return err
+	}
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	as, err := json.Marshal(attributes)
+	if err != nil {
+		return fmt.Errorf("marshal attributes: %s", err)
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(texts); start += insertBatchSize {
+			end := min(start+insertBatchSize, len(texts))
+			var (
+				values []string
+				args   []any
+			)
+			for i := start; i < end; i++ {
+				m := map[string]any{}
+				if i < len(metadatas) && metadatas[i] != nil {
+					m = metadatas[i]
+				}
+				ms, err := json.Marshal(m)
+				if err != nil {
+					return fmt.Errorf("marshal metadata: %s", err)
+				}
+				var parentID string
+				if i < len(parentIDs) {
+					parentID = parentIDs[i]
+				}
+				var chunkIndex int64
+				if i < len(chunkIndexes) {
+					chunkIndex = chunkIndexes[i]
+				}
+				values = append(values, "(?, ?, ?::jsonb, ?, ?, ?::jsonb, ?::vector)")
+				args = append(args, files[i], texts[i], string(ms), parentID, chunkIndex, string(as), vectorLiteral(vectors[i]))
+			}
+			stmt := fmt.Sprintf(
+				"INSERT INTO %s (file_id, text, metadata, parent_id, chunk_index, attributes, vector) VALUES %s",
+				c.documentTable(),
+				strings.Join(values, ", "),
+			)
+			if err := tx.Exec(stmt, args...).Error; err != nil {
+				return fmt.Errorf("insert documents: %s", err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteDocuments deletes the documents of a file from a collection.
+func (s *S) DeleteDocuments(ctx context.Context, collectionName, fileID string) error {
+	c, err := s.getCollection(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+	stmt := fmt.Sprintf("DELETE FROM %s WHERE file_id = ?", c.documentTable())
+	return s.db.WithContext(ctx).Exec(stmt, fileID).Error
+}
+
+// UpdateAttributes replaces the attributes of the documents of a file.
+func (s *S) UpdateAttributes(ctx context.Context, collectionName, fileID string, attributes map[string]any) error {
+	c, err := s.getCollection(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	b, err := json.Marshal(attributes)
+	if err != nil {
+		return fmt.Errorf("marshal attributes: %s", err)
+	}
+	stmt := fmt.Sprintf("UPDATE %s SET attributes = ?::jsonb WHERE file_id = ?", c.documentTable())
+	return s.db.WithContext(ctx).Exec(stmt, string(b), fileID).Error
+}
+
+// Search searches for the documents with similar vectors. The matched documents are returned in the order
+// of similarity. The index must be the one that the collection is indexed with. Only the documents that
+// match the filter are returned if it is not nil. The vectors of the documents are also returned if
+// withVectors is true.
+func (s *S) Search(
+	ctx context.Context,
+	collectionName string,
+	index vectordb.Index,
+	vectors []float32,
+	numDocuments int,
+	filter *vectordb.Filter,
+	withVectors bool,
+) ([]vectordb.Document, error) {
+	c, err := s.getCollection(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	index = index.WithDefaults()
+	op, err := distanceOperator(index.MetricType)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		where string
+		args  []any
+	)
+	if filter != nil {
+		where, args, err = filterSQL(filter)
+		if err != nil {
+			return nil, fmt.Errorf("filter: %s", err)
+		}
+		where = "WHERE " + where
+	}
+	columns := "id, file_id, text, metadata, parent_id, chunk_index, attributes"
+	if withVectors {
+		columns += ", vector::text"
+	}
+	v := vectorLiteral(vectors)
+	stmt := fmt.Sprintf(
+		"SELECT %s, vector %s ?::vector AS distance FROM %s %s ORDER BY vector %s ?::vector LIMIT ?",
+		columns,
+		op,
+		c.documentTable(),
+		where,
+		op,
+	)
+	args = append([]any{v}, args...)
+	args = append(args, v, numDocuments)
+
+	var docs []vectordb.Document
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if setting := searchSettingStatement(index, numDocuments); setting != "" {
+			if err := tx.Exec(setting).Error; err != nil {
+				return fmt.Errorf("set search parameter: %s", err)
+			}
+		}
+		rows, err := tx.Raw(stmt, args...).Rows()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = rows.Close()
+		}()
+		for rows.Next() {
+			var distance float64
+			d, err := scanDocument(rows, withVectors, &distance)
+			if err != nil {
+				return err
+			}
+			d.Distance = float32(distance)
+			d.Score = similarity(index.MetricType, d.Distance)
+			docs = append(docs, d)
+		}
+		return rows.Err()
+	}); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// HybridSearch returns vectordb.ErrHybridSearchNotSupported as keyword search is not supported.
+func (s *S) HybridSearch(
+	ctx context.Context,
+	collectionName string,
+	index vectordb.Index,
+	vectors []float32,
+	query string,
+	numDocuments int,
+	filter *vectordb.Filter,
+	fusion vectordb.Fusion,
+	withVectors bool,
+) ([]vectordb.Document, error) {
+	return nil, vectordb.ErrHybridSearchNotSupported
+}
+
+// ListDocumentsByChunkRanges lists the documents in the given ranges of chunks. The documents are
+// returned in no particular order.
+func (s *S) ListDocumentsByChunkRanges(ctx context.Context, collectionName string, ranges []vectordb.ChunkRange) ([]vectordb.Document, error) {
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+	c, err := s.getCollection(ctx, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		conds []string
+		args  []any
+	)
+	for _, r := range ranges {
+		conds = append(conds, "(file_id = ? AND chunk_index BETWEEN ? AND ?)")
+		args = append(args, r.FileID, r.Start, r.End)
+	}
+	stmt := fmt.Sprintf(
+		"SELECT id, file_id, text, metadata, parent_id, chunk_index, attributes FROM %s WHERE %s",
+		c.documentTable(),
+		strings.Join(conds, " OR "),
+	)
+	rows, err := s.db.WithContext(ctx).Raw(stmt, args...).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+	var docs []vectordb.Document
+	for rows.Next() {
+		d, err := scanDocument(rows, false)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (s *S) getCollection(ctx context.Context, name string) (*collection, error) {
+	var c collection
+	if err := s.db.WithContext(ctx).Where("name = ?", name).Take(&c).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("collection %q not found", name)
+		}
+		return nil, fmt.Errorf("get collection: %s", err)
+	}
+	return &c, nil
+}
+
+func createTableStatement(c *collection) string {
+	return fmt.Sprintf(`CREATE TABLE %s (
+	id BIGSERIAL PRIMARY KEY,
+	file_id TEXT NOT NULL,
+	text TEXT NOT NULL,
+	metadata JSONB NOT NULL,
+	parent_id TEXT NOT NULL,
+	chunk_index BIGINT NOT NULL,
+	attributes JSONB NOT NULL,
+	vector vector(%d) NOT NULL
+)`, c.documentTable(), c.Dimensions)
+}
+
+// scanDocument scans a row of the id, file_id, text, metadata, parent_id, chunk_index and attributes columns,
+// the vector column if withVectors is true, and then the extra columns.
+func scanDocument(rows *sql.Rows, withVectors bool, extra ...any) (vectordb.Document, error) {
+	var (
+		d          vectordb.Document
+		metadata   []byte
+		attributes []byte
+		vector     string
+	)
+	dest := []any{&d.ID, &d.FileID, &d.Text, &metadata, &d.ParentID, &d.ChunkIndex, &attributes}
+	if withVectors {
+		dest = append(dest, &vector)
+	}
+	dest = append(dest, extra...)
+	if err := rows.Scan(dest...); err != nil {
+		return vectordb.Document{}, fmt.Errorf("scan document: %s", err)
+	}
+	if err := json.Unmarshal(metadata, &d.Metadata); err != nil {
+		return vectordb.Document{}, fmt.Errorf("unmarshal metadata: %s", err)
+	}
+	if err := json.Unmarshal(attributes, &d.Attributes); err != nil {
+		return vectordb.Document{}, fmt.Errorf("unmarshal attributes: %s", err)
+	}
+	if withVectors {
+		v, err := parseVector(vector)
+		if err != nil {
+			return vectordb.Document{}, err
+		}
+		d.Vector = v
+	}
+	return d, nil
+}
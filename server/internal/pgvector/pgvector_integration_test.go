@@ -0,0 +1,202 @@
+//go:build integration
+// +build integration
+
+package pgvector
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/common/pkg/db"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsertSearchDeleteDocuments(t *testing.T) {
+	const (
+		collectionName    = "test_collection_1"
+		collectionNameNew = "test_collection_1_new"
+		dimensions        = 4
+	)
+
+	vectors := [][]float32{
+		{-0.2161688655614853, 0.4428754150867462, 0.12087928503751755, 0.38950398564338684},
+		{-0.023337043821811676, 0.19466467201709747, -0.5630808472633362, 0.5578770637512207},
+		{-0.2161688655614855, 0.4428754150867464, 0.12087928503751755, 0.38950398564338684},
+	}
+	query := []float32{-0.023337043821811676, 0.19466467201709747, -0.5630808472633364, 0.5578770637512209}
+	fileIDs := []string{"file-001", "file-001", "file-002"}
+	texts := []string{"hello", "world", "bye"}
+
+	cfg := db.Config{
+		Host:            "localhost",
+		Port:            5432,
+		Username:        "postgres",
+		Database:        "postgres",
+		PasswordEnvName: "PGPASSWORD",
+		SSL:             db.SSLConfig{Mode: "disable"},
+	}
+	ctx := context.Background()
+	s, err := New(ctx, cfg, testr.New(t))
+	assert.NoError(t, err)
+
+	preExist, err := s.ListVectorStores(ctx)
+	assert.NoError(t, err)
+
+	index := vectordb.Index{MetricType: vectordb.MetricTypeCosine, Type: vectordb.IndexTypeIVFFlat, NList: 1}
+	_, err = s.CreateVectorStore(ctx, collectionName, dimensions, index)
+	assert.NoError(t, err)
+
+	vss, err := s.ListVectorStores(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, len(preExist)+1, len(vss))
+
+	err = s.InsertDocuments(ctx, collectionName, fileIDs[:2], texts[:2], nil, nil, []int64{0, 1}, map[string]any{"year": 2024.0}, vectors[:2])
+	assert.NoError(t, err)
+	err = s.InsertDocuments(ctx, collectionName, fileIDs[2:], texts[2:], nil, nil, []int64{0}, map[string]any{"year": 2020.0}, vectors[2:])
+	assert.NoError(t, err)
+
+	got, err := s.Search(ctx, collectionName, index, query, 1, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "world", got[0].Text)
+	assert.Equal(t, int64(1), got[0].ChunkIndex)
+	assert.Equal(t, map[string]any{"year": 2024.0}, got[0].Attributes)
+	assert.Len(t, got[0].Vector, dimensions)
+	assert.Greater(t, got[0].Score, float32(0.99))
+
+	got, err = s.Search(ctx, collectionName, index, query, 10, &vectordb.Filter{Type: vectordb.FilterTypeLt, Key: "year", Value: 2022.0}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "bye", got[0].Text)
+
+	err = s.UpdateAttributes(ctx, collectionName, "file-002", map[string]any{"year": 2025.0})
+	assert.NoError(t, err)
+	got, err = s.Search(ctx, collectionName, index, query, 10, &vectordb.Filter{Type: vectordb.FilterTypeLt, Key: "year", Value: 2022.0}, false)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	got, err = s.ListDocumentsByChunkRanges(ctx, collectionName, []vectordb.ChunkRange{{FileID: "file-001", Start: 0, End: 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(got))
+
+	err = s.DeleteDocuments(ctx, collectionName, "file-001")
+	assert.NoError(t, err)
+
+	got, err = s.Search(ctx, collectionName, index, query, 10, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "bye", got[0].Text)
+
+	hnsw := vectordb.Index{MetricType: vectordb.MetricTypeCosine, Type: vectordb.IndexTypeHNSW}
+	err = s.RebuildIndex(ctx, collectionName, hnsw)
+	assert.NoError(t, err)
+	got, err = s.Search(ctx, collectionName, hnsw, query, 10, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+
+	err = s.UpdateVectorStoreName(ctx, collectionName, collectionNameNew)
+	assert.NoError(t, err)
+
+	err = s.DeleteVectorStore(ctx, collectionNameNew)
+	assert.NoError(t, err)
+
+	vss, err = s.ListVectorStores(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, len(preExist), len(vss))
+}
+
+// TestSearch_RecallAfterFill checks the recall of the default index of pgvector when the documents are
+// inserted after the vector store is created.
+func TestSearch_RecallAfterFill(t *testing.T) {
+	const (
+		collectionName = "test_collection_recall"
+		dimensions     = 16
+		numVectors     = 2000
+		numQueries     = 20
+		numDocuments   = 10
+	)
+
+	cfg := db.Config{
+		Host:            "localhost",
+		Port:            5432,
+		Username:        "postgres",
+		Database:        "postgres",
+		PasswordEnvName: "PGPASSWORD",
+		SSL:             db.SSLConfig{Mode: "disable"},
+	}
+	ctx := context.Background()
+	s, err := New(ctx, cfg, testr.New(t))
+	assert.NoError(t, err)
+
+	// The default index type of pgvector.
+	index := vectordb.Index{Type: vectordb.IndexTypeHNSW}.WithDefaults()
+	_, err = s.CreateVectorStore(ctx, collectionName, dimensions, index)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, s.DeleteVectorStore(ctx, collectionName))
+	}()
+
+	r := rand.New(rand.NewSource(0))
+	randomVector := func() []float32 {
+		v := make([]float32, dimensions)
+		for i := range v {
+			v[i] = r.Float32()*2 - 1
+		}
+		return v
+	}
+	var (
+		fileIDs      []string
+		texts        []string
+		chunkIndexes []int64
+		vectors      [][]float32
+	)
+	for i := 0; i < numVectors; i++ {
+		fileIDs = append(fileIDs, "file-001")
+		texts = append(texts, fmt.Sprintf("%d", i))
+		chunkIndexes = append(chunkIndexes, int64(i))
+		vectors = append(vectors, randomVector())
+	}
+	err = s.InsertDocuments(ctx, collectionName, fileIDs, texts, nil, nil, chunkIndexes, nil, vectors)
+	assert.NoError(t, err)
+
+	var found int
+	for q := 0; q < numQueries; q++ {
+		query := randomVector()
+		got, err := s.Search(ctx, collectionName, index, query, numDocuments, nil, false)
+		assert.NoError(t, err)
+		assert.Len(t, got, numDocuments)
+
+		// The exact nearest neighbors.
+		ids := make([]int, numVectors)
+		for i := range ids {
+			ids[i] = i
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			return squaredDistance(vectors[ids[i]], query) < squaredDistance(vectors[ids[j]], query)
+		})
+		want := map[string]bool{}
+		for _, id := range ids[:numDocuments] {
+			want[texts[id]] = true
+		}
+		for _, d := range got {
+			if want[d.Text] {
+				found++
+			}
+		}
+	}
+	recall := float64(found) / float64(numQueries*numDocuments)
+	assert.GreaterOrEqual(t, recall, 0.9)
+}
+
+func squaredDistance(a, b []float32) float32 {
+	var d float32
+	for i := range a {
+		d += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return d
+}
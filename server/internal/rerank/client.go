@@ -0,0 +1,145 @@
+package rerank
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Format is the request and response format of a /rerank endpoint.
+type Format string
+
+const (
+	// FormatTEI is the format of the /rerank endpoint of Text Embeddings Inference.
+	FormatTEI Format = "tei"
+	// FormatCohere is the format of the Cohere /v1/rerank endpoint. It is also served by other engines
+	// such as Infinity.
+	FormatCohere Format = "cohere"
+)
+
+// NewClient returns a new client for the /rerank endpoint served at the address.
+func NewClient(addr string, format Format) *Client {
+	path := "/rerank"
+	if format == FormatCohere {
+		path = "/v1/rerank"
+	}
+	return &Client{
+		url:    fmt.Sprintf("http://%s%s", addr, path),
+		format: format,
+		client: http.DefaultClient,
+	}
+}
+
+// Client is a client for a /rerank endpoint.
+type Client struct {
+	url    string
+	format Format
+	client *http.Client
+}
+
+type teiRequest struct {
+	Query string   `json:"query"`
+	Texts []string `json:"texts"`
+}
+
+type teiResult struct {
+	Index int     `json:"index"`
+	Score float32 `json:"score"`
+}
+
+type cohereRequest struct {
+	Model     string   `json:"model,omitempty"`
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+}
+
+type cohereResponse struct {
+	Results []struct {
+		Index          int     `json:"index"`
+		RelevanceScore float32 `json:"relevance_score"`
+	} `json:"results"`
+}
+
+// Rerank returns the relevance scores of the texts to the query in the same order as the texts.
+// The model is ignored by Text Embeddings Inference, which serves a single model.
+func (c *Client) Rerank(ctx context.Context, modelName, query string, texts []string) ([]float32, error) {
+	var (
+		indexes []int
+		scores  []float32
+	)
+	switch c.format {
+	case FormatTEI:
+		var resp []teiResult
+		if err := postJSON(ctx, c.client, c.url, &teiRequest{Query: query, Texts: texts}, &resp); err != nil {
+			return nil, err
+		}
+		for _, r := range resp {
+			indexes = append(indexes, r.Index)
+			scores = append(scores, r.Score)
+		}
+	case FormatCohere:
+		var resp cohereResponse
+		if err := postJSON(ctx, c.client, c.url, &cohereRequest{Model: modelName, Query: query, Documents: texts}, &resp); err != nil {
+			return nil, err
+		}
+		for _, r := range resp.Results {
+			indexes = append(indexes, r.Index)
+			scores = append(scores, r.RelevanceScore)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format %q", c.format)
+	}
+	return orderScores(len(texts), indexes, scores)
+}
+
+// orderScores puts the scores in the order of the texts. The results of reranking engines are sorted
+// by their scores and refer to the texts by their indexes.
+func orderScores(numTexts int, indexes []int, scores []float32) ([]float32, error) {
+	if len(indexes) != numTexts {
+		return nil, fmt.Errorf("unexpected number of scores: got %d, want %d", len(indexes), numTexts)
+	}
+	ordered := make([]float32, numTexts)
+	seen := make([]bool, numTexts)
+	for i, idx := range indexes {
+		if idx < 0 || idx >= numTexts || seen[idx] {
+			return nil, fmt.Errorf("unexpected score index: %d", idx)
+		}
+		seen[idx] = true
+		ordered[idx] = scores[i]
+	}
+	return ordered, nil
+}
+
+// postJSON sends the request in JSON and decodes the JSON response into resp.
+func postJSON(ctx context.Context, client *http.Client, url string, req, resp any) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %s", err)
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	hresp, err := client.Do(hreq)
+	if err != nil {
+		return fmt.Errorf("send request: %s", err)
+	}
+	defer func() {
+		_ = hresp.Body.Close()
+	}()
+	body, err := io.ReadAll(hresp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %s", err)
+	}
+	if hresp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", hresp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return fmt.Errorf("unmarshal response: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package rerank
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type reranker interface {
+	Rerank(ctx context.Context, modelName, query string, texts []string) ([]float32, error)
+}
+
+func TestRerank(t *testing.T) {
+	tcs := []struct {
+		name      string
+		newClient func(addr string) reranker
+		wantPath  string
+		wantReq   map[string]any
+		resp      string
+		status    int
+		want      []float32
+		wantErr   bool
+	}{
+		{
+			name:      "tei",
+			newClient: func(addr string) reranker { return NewClient(addr, FormatTEI) },
+			wantPath:  "/rerank",
+			wantReq:   map[string]any{"query": "q", "texts": []any{"a", "b"}},
+			resp:      `[{"index": 1, "score": 0.9}, {"index": 0, "score": 0.1}]`,
+			want:      []float32{0.1, 0.9},
+		},
+		{
+			name:      "cohere",
+			newClient: func(addr string) reranker { return NewClient(addr, FormatCohere) },
+			wantPath:  "/v1/rerank",
+			wantReq:   map[string]any{"model": "m", "query": "q", "documents": []any{"a", "b"}},
+			resp:      `{"results": [{"index": 0, "relevance_score": 0.8}, {"index": 1, "relevance_score": 0.3}]}`,
+			want:      []float32{0.8, 0.3},
+		},
+		{
+			name:      "vllm",
+			newClient: func(addr string) reranker { return NewVLLMClient(addr) },
+			wantPath:  "/score",
+			wantReq:   map[string]any{"model": "m", "text_1": "q", "text_2": []any{"a", "b"}},
+			resp:      `{"data": [{"index": 0, "score": 0.4}, {"index": 1, "score": 0.6}]}`,
+			want:      []float32{0.4, 0.6},
+		},
+		{
+			name:      "missing score",
+			newClient: func(addr string) reranker { return NewClient(addr, FormatTEI) },
+			wantPath:  "/rerank",
+			wantReq:   map[string]any{"query": "q", "texts": []any{"a", "b"}},
+			resp:      `[{"index": 1, "score": 0.9}]`,
+			wantErr:   true,
+		},
+		{
+			name:      "duplicate index",
+			newClient: func(addr string) reranker { return NewVLLMClient(addr) },
+			wantPath:  "/score",
+			wantReq:   map[string]any{"model": "m", "text_1": "q", "text_2": []any{"a", "b"}},
+			resp:      `{"data": [{"index": 0, "score": 0.4}, {"index": 0, "score": 0.6}]}`,
+			wantErr:   true,
+		},
+		{
+			name:      "error status",
+			newClient: func(addr string) reranker { return NewClient(addr, FormatCohere) },
+			wantPath:  "/v1/rerank",
+			wantReq:   map[string]any{"model": "m", "query": "q", "documents": []any{"a", "b"}},
+			resp:      `{"message": "model not found"}`,
+			status:    http.StatusNotFound,
+			wantErr:   true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tc.wantPath, r.URL.Path)
+				var req map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				assert.Equal(t, tc.wantReq, req)
+				if tc.status != 0 {
+					w.WriteHeader(tc.status)
+				}
+				_, _ = w.Write([]byte(tc.resp))
+			}))
+			defer srv.Close()
+
+			c := tc.newClient(strings.TrimPrefix(srv.URL, "http://"))
+			got, err := c.Rerank(context.Background(), "m", "q", []string{"a", "b"})
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
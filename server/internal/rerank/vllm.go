@@ -0,0 +1,51 @@
+package rerank
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// NewVLLMClient returns a new client for the score API of vLLM served at the address.
+func NewVLLMClient(addr string) *VLLMClient {
+	return &VLLMClient{
+		url:    fmt.Sprintf("http://%s/score", addr),
+		client: http.DefaultClient,
+	}
+}
+
+// VLLMClient is a client for the score API of vLLM. The API scores pairs of texts with a cross-encoder model.
+type VLLMClient struct {
+	url    string
+	client *http.Client
+}
+
+type scoreRequest struct {
+	Model string   `json:"model"`
+	Text1 string   `json:"text_1"`
+	Text2 []string `json:"text_2"`
+}
+
+type scoreResponse struct {
+	Data []struct {
+		Index int     `json:"index"`
+		Score float32 `json:"score"`
+	} `json:"data"`
+}
+
+// Rerank returns the relevance scores of the texts to the query in the same order as the texts.
+func (c *VLLMClient) Rerank(ctx context.Context, modelName, query string, texts []string) ([]float32, error) {
+	var resp scoreResponse
+	if err := postJSON(ctx, c.client, c.url, &scoreRequest{Model: modelName, Text1: query, Text2: texts}, &resp); err != nil {
+		return nil, err
+	}
+	var (
+		indexes []int
+		scores  []float32
+	)
+	for _, d := range resp.Data {
+		indexes = append(indexes, d.Index)
+		scores = append(scores, d.Score)
+	}
+	return orderScores(len(texts), indexes, scores)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// validateAttributes validates the attributes of a file. The limits are the same as the ones of the
+// metadata of a vector store.
+func validateAttributes(attributes map[string]*structpb.Value) error {
+	if len(attributes) > maxMetadataEntries {
+		return status.Errorf(codes.InvalidArgument, "No more than %d attributes are allowed", maxMetadataEntries)
+	}
+	for k, v := range attributes {
+		if len(k) > maxMetadataKeyLength {
+			return status.Errorf(codes.InvalidArgument, "Attribute key %q is too long, max allowed is %d", k, maxMetadataKeyLength)
+		}
+		switch v.GetKind().(type) {
+		case *structpb.Value_StringValue:
+			if len(v.GetStringValue()) > maxMetadataValueLength {
+				return status.Errorf(codes.InvalidArgument, "Attribute value for key %q is too long, max allowed is %d", k, maxMetadataValueLength)
+			}
+		case *structpb.Value_NumberValue, *structpb.Value_BoolValue:
+		default:
+			return status.Errorf(codes.InvalidArgument, "Attribute value for key %q must be a string, a number or a boolean", k)
+		}
+	}
+	return nil
+}
+
+// marshalAttributes encodes the attributes in JSON. It returns nil if there is no attribute.
+func marshalAttributes(attributes map[string]*structpb.Value) ([]byte, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(fromAttributesProto(attributes))
+	if err != nil {
+		return nil, fmt.Errorf("marshal attributes: %s", err)
+	}
+	return b, nil
+}
+
+// fromAttributesProto converts proto values to the attributes.
+func fromAttributesProto(attributes map[string]*structpb.Value) map[string]any {
+	m := map[string]any{}
+	for k, v := range attributes {
+		m[k] = v.AsInterface()
+	}
+	return m
+}
+
+// toAttributesProto converts the attributes to proto values. Attributes that cannot be converted are skipped.
+func toAttributesProto(attributes map[string]any) map[string]*structpb.Value {
+	if len(attributes) == 0 {
+		return nil
+	}
+	pm := map[string]*structpb.Value{}
+	for k, v := range attributes {
+		pv, err := structpb.NewValue(v)
+		if err != nil {
+			continue
+		}
+		pm[k] = pv
+	}
+	return pm
+}
+
+// unmarshalAttributesProto decodes the attributes encoded by marshalAttributes.
+func unmarshalAttributesProto(b []byte) (map[string]*structpb.Value, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal attributes: %s", err)
+	}
+	return toAttributesProto(m), nil
+}
+
+// toFilter converts a search filter to a vector store filter.
+func toFilter(f *v1.VectorStoreSearchFilter) (*vectordb.Filter, error) {
+	ret := convertFilter(f)
+	if err := ret.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %s", err)
+	}
+	return ret, nil
+}
+
+func convertFilter(f *v1.VectorStoreSearchFilter) *vectordb.Filter {
+	ret := &vectordb.Filter{
+		Type: vectordb.FilterType(f.Type),
+		Key:  f.Key,
+	}
+	if f.Value != nil {
+		ret.Value = f.Value.AsInterface()
+	}
+	for _, sf := range f.Filters {
+		ret.Filters = append(ret.Filters, convertFilter(sf))
+	}
+	return ret
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// updateIndexAttempts is the number of attempts to record a rebuilt index.
+	updateIndexAttempts = 3
+	// updateIndexBackoff is the backoff between the attempts to record a rebuilt index.
+	updateIndexBackoff = time.Second
+)
+
+// RebuildVectorStoreIndex replaces the index of the vectors of a vector store with a new one. Milvus drops the
+// index and builds the new one, and restores the old index if the new one cannot be built. pgvector builds the
+// new index concurrently and replaces the old one once it is ready.
+func (s *IS) RebuildVectorStoreIndex(
+	ctx context.Context,
+	req *v1.RebuildVectorStoreIndexRequest,
+) (*v1.RebuildVectorStoreIndexResponse, error) {
+	if req.VectorStoreId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vector_store_id is required")
+	}
+	if req.Index == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "index is required")
+	}
+
+	c, err := s.store.GetCollectionByVectorStoreIDInternal(req.VectorStoreId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
+	}
+
+	// The metric is kept as the stored vectors are not changed.
+	oldIndex := collectionIndex(c)
+	index := overrideIndex(oldIndex, req.Index)
+	if err := s.indexRebuilder.ValidateIndex(index, c.EmbeddingDimensions); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid index: %s", err)
+	}
+	if err := s.indexRebuilder.RebuildIndex(ctx, c.VectorStoreID, index); err != nil {
+		return nil, status.Errorf(codes.Internal, "rebuild index: %s", err)
+	}
+
+	// Searches use the parameters in the database, so the rebuilt index is recorded even if the request is
+	// cancelled. The old index is rebuilt if it cannot be recorded.
+	ctx = context.WithoutCancel(ctx)
+	setCollectionIndex(c, index)
+	if err := s.updateCollectionIndex(c); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+		}
+		if err := s.indexRebuilder.RebuildIndex(ctx, c.VectorStoreID, oldIndex); err != nil {
+			s.log.Error(err, "Failed to restore the old index", "vectorStore", c.VectorStoreID)
+		}
+		return nil, status.Errorf(codes.Internal, "update collection index: %s", err)
+	}
+	s.log.Info("Rebuilt index", "vectorStore", c.VectorStoreID, "index", index)
+	return &v1.RebuildVectorStoreIndexResponse{
+		Index: toVectorIndexProto(index),
+	}, nil
+}
+
+// updateCollectionIndex records the index of the collection. Errors other than gorm.ErrRecordNotFound are
+// retried as the index has already been rebuilt.
+func (s *IS) updateCollectionIndex(c *store.Collection) error {
+	var err error
+	for i := 0; i < updateIndexAttempts; i++ {
+		if i > 0 {
+			time.Sleep(updateIndexBackoff)
+		}
+		if err = s.store.UpdateCollectionIndex(c); err == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		s.log.Error(err, "Failed to update the collection index", "vectorStore", c.VectorStoreID, "attempt", i+1)
+	}
+	return err
+}
+
+// collectionIndex returns the index of the vectors of the collection.
+func collectionIndex(c *store.Collection) vectordb.Index {
+	return vectordb.Index{
+		MetricType:     vectordb.MetricType(c.MetricType),
+		Type:           vectordb.IndexType(c.IndexType),
+		NList:          c.IndexNList,
+		NProbe:         c.IndexNProbe,
+		M:              c.IndexM,
+		NBits:          c.IndexNBits,
+		EFConstruction: c.IndexEFConstruction,
+		EF:             c.IndexEF,
+		SearchList:     c.IndexSearchList,
+	}.WithDefaults()
+}
+
+// setCollectionIndex records the index on the collection.
+func setCollectionIndex(c *store.Collection, index vectordb.Index) {
+	c.MetricType = string(index.MetricType)
+	c.IndexType = string(index.Type)
+	c.IndexNList = index.NList
+	c.IndexNProbe = index.NProbe
+	c.IndexM = index.M
+	c.IndexNBits = index.NBits
+	c.IndexEFConstruction = index.EFConstruction
+	c.IndexEF = index.EF
+	c.IndexSearchList = index.SearchList
+}
+
+// overrideIndex returns the index with the type and the parameters that are set in vi. The parameters of the
+// base index are kept if the type is the same, and the defaults of the type are used otherwise.
+func overrideIndex(base vectordb.Index, vi *v1.VectorIndex) vectordb.Index {
+	index := base.WithDefaults()
+	if vi == nil {
+		return index
+	}
+	if t := vectordb.IndexType(vi.Type); t != "" && t != index.Type {
+		index = vectordb.Index{
+			MetricType: index.MetricType,
+			Type:       t,
+		}
+	}
+	if vi.Nlist != 0 {
+		index.NList = int(vi.Nlist)
+	}
+	if vi.Nprobe != 0 {
+		index.NProbe = int(vi.Nprobe)
+	}
+	if vi.M != 0 {
+		index.M = int(vi.M)
+	}
+	if vi.Nbits != 0 {
+		index.NBits = int(vi.Nbits)
+	}
+	if vi.EfConstruction != 0 {
+		index.EFConstruction = int(vi.EfConstruction)
+	}
+	if vi.Ef != 0 {
+		index.EF = int(vi.Ef)
+	}
+	if vi.SearchList != 0 {
+		index.SearchList = int(vi.SearchList)
+	}
+	return index.WithDefaults()
+}
+
+func toVectorIndexProto(index vectordb.Index) *v1.VectorIndex {
+	return &v1.VectorIndex{
+		Type:           string(index.Type),
+		Nlist:          int32(index.NList),
+		Nprobe:         int32(index.NProbe),
+		M:              int32(index.M),
+		Nbits:          int32(index.NBits),
+		EfConstruction: int32(index.EFConstruction),
+		Ef:             int32(index.EF),
+		SearchList:     int32(index.SearchList),
+	}
+}
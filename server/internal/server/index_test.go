@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRebuildVectorStoreIndex(t *testing.T) {
+	tcs := []struct {
+		name      string
+		req       *v1.RebuildVectorStoreIndexRequest
+		wantIndex vectordb.Index
+		wantErr   bool
+	}{
+		{
+			name: "success",
+			req: &v1.RebuildVectorStoreIndexRequest{
+				VectorStoreId: vectorStoreName,
+				Index: &v1.VectorIndex{
+					Type: string(vectordb.IndexTypeHNSW),
+					Ef:   128,
+				},
+			},
+			wantIndex: vectordb.Index{
+				MetricType:     vectordb.MetricTypeCosine,
+				Type:           vectordb.IndexTypeHNSW,
+				M:              16,
+				EFConstruction: 200,
+				EF:             128,
+			},
+		},
+		{
+			name: "success with the same type",
+			req: &v1.RebuildVectorStoreIndexRequest{
+				VectorStoreId: vectorStoreName,
+				Index: &v1.VectorIndex{
+					Nprobe: 32,
+				},
+			},
+			wantIndex: vectordb.Index{
+				MetricType: vectordb.MetricTypeCosine,
+				Type:       vectordb.IndexTypeIVFFlat,
+				NList:      128,
+				NProbe:     32,
+			},
+		},
+		{
+			name: "not found",
+			req: &v1.RebuildVectorStoreIndexRequest{
+				VectorStoreId: "unknown",
+				Index: &v1.VectorIndex{
+					Type: string(vectordb.IndexTypeFlat),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid index",
+			req: &v1.RebuildVectorStoreIndexRequest{
+				VectorStoreId: vectorStoreName,
+				Index: &v1.VectorIndex{
+					Type: string(vectordb.IndexTypeIVFPQ),
+					M:    3,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no index",
+			req: &v1.RebuildVectorStoreIndexRequest{
+				VectorStoreId: vectorStoreName,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:        1,
+				VectorStoreID:       vectorStoreName,
+				Name:                vectorStoreName,
+				Status:              store.CollectionStatusCompleted,
+				ProjectID:           "default",
+				EmbeddingDimensions: dimensions,
+				MetricType:          string(vectordb.MetricTypeCosine),
+			})
+			assert.NoError(t, err)
+
+			vs := &noopVStoreClient{
+				vs: map[string]int64{vectorStoreName: 1},
+			}
+			srv := NewInternal(st, modelName, &noopRetriever{}, vs, testr.New(t))
+			resp, err := srv.RebuildVectorStoreIndex(context.Background(), tc.req)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(toVectorIndexProto(tc.wantIndex), resp.Index), "got %v", resp.Index)
+			assert.Equal(t, tc.wantIndex, vs.index)
+
+			c, err := st.GetCollectionByVectorStoreIDInternal(vectorStoreName)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantIndex, collectionIndex(c))
+		})
+	}
+}
@@ -7,20 +7,37 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 type retriever interface {
-	Search(ctx context.Context, collectionName, modelName, query string, numDocs int) ([]string, error)
+	Search(
+		ctx context.Context,
+		collectionName, modelName string,
+		index vectordb.Index,
+		query string,
+		numDocs int,
+		opts embedder.SearchOptions,
+	) ([]embedder.SearchResult, error)
+}
+
+type indexRebuilder interface {
+	RebuildIndex(ctx context.Context, name string, index vectordb.Index) error
+	ValidateIndex(index vectordb.Index, dimensions int) error
 }
 
 // NewInternal creates an internal server.
-func NewInternal(model string, r retriever, log logr.Logger) *IS {
+func NewInternal(st *store.S, model string, r retriever, ir indexRebuilder, log logr.Logger) *IS {
 	return &IS{
-		model:     model,
-		retriever: r,
-		log:       log.WithName("internal"),
+		store:          st,
+		model:          model,
+		retriever:      r,
+		indexRebuilder: ir,
+		log:            log.WithName("internal"),
 	}
 }
 
@@ -28,10 +45,12 @@ func NewInternal(model string, r retriever, log logr.Logger) *IS {
 type IS struct {
 	v1.UnimplementedVectorStoreInternalServiceServer
 
-	model     string
-	retriever retriever
-	srv       *grpc.Server
-	log       logr.Logger
+	store          *store.S
+	model          string
+	retriever      retriever
+	indexRebuilder indexRebuilder
+	srv            *grpc.Server
+	log            logr.Logger
 }
 
 // Run starts the internal gRPC server.
@@ -2,15 +2,26 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 const (
 	defaultNumDocuments = 10
 	maxNumDocuments     = 100
+	maxContextWindow    = 10
+
+	searchModeVector = "vector"
+	searchModeHybrid = "hybrid"
+
+	defaultVectorWeight = 0.5
+	defaultMMRLambda    = 0.5
 )
 
 // SearchVectorStore searches documents for the given query from a vector store.
@@ -30,6 +41,42 @@ func (s *IS) SearchVectorStore(
 		return nil, status.Errorf(codes.InvalidArgument, "num_documents must be non-negative")
 	}
 
+	if req.ContextWindow < 0 || req.ContextWindow > maxContextWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "context_window must be between 0 and %d", maxContextWindow)
+	}
+
+	hybrid, err := getFusion(req)
+	if err != nil {
+		return nil, err
+	}
+
+	mmr, err := getMMR(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.MaxResultsPerFile < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_results_per_file must be non-negative")
+	}
+
+	if req.ScoreThreshold < 0 || req.ScoreThreshold > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "score_threshold must be between 0 and 1")
+	}
+
+	c, err := s.store.GetCollectionByVectorStoreIDInternal(req.VectorStoreId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
+	}
+
+	rerank := req.Rerank || c.Rerank
+	if req.ScoreThreshold > 0 && hybrid != nil && hybrid.Type == vectordb.FusionTypeRRF && !rerank {
+		// The score of reciprocal rank fusion reflects only the ranks, not the relevance.
+		return nil, status.Errorf(codes.InvalidArgument, "score_threshold is not supported by the %s fusion unless the results are reranked", vectordb.FusionTypeRRF)
+	}
+
 	numDocs := int(req.NumDocuments)
 	if numDocs == 0 {
 		numDocs = defaultNumDocuments
@@ -38,11 +85,97 @@ func (s *IS) SearchVectorStore(
 		numDocs = maxNumDocuments
 	}
 
-	docs, err := s.retriever.Search(ctx, req.VectorStoreId, s.model, req.Query, numDocs)
+	results, err := s.retriever.Search(ctx, req.VectorStoreId, s.model, collectionIndex(c), req.Query, numDocs, embedder.SearchOptions{
+		ContextWindow:     int(req.ContextWindow),
+		Hybrid:            hybrid,
+		Rerank:            rerank,
+		MMR:               mmr,
+		MaxResultsPerFile: int(req.MaxResultsPerFile),
+		ScoreThreshold:    float64(req.ScoreThreshold),
+		LegacyEmbedding:   !c.BatchEmbeddings,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "search vector store: %s", err)
+		return nil, searchError(err)
+	}
+	resp := &v1.SearchVectorStoreResponse{}
+	for _, r := range results {
+		resp.Documents = append(resp.Documents, r.Text)
+		resp.Results = append(resp.Results, toSearchResultProto(r))
+	}
+	return resp, nil
+}
+
+// getFusion returns the fusion of hybrid search. It returns nil for vector search.
+func getFusion(req *v1.SearchVectorStoreRequest) (*vectordb.Fusion, error) {
+	switch req.SearchMode {
+	case "", searchModeVector:
+		if req.Fusion != "" || req.VectorWeight != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "fusion and vector_weight are supported only by hybrid search")
+		}
+		return nil, nil
+	case searchModeHybrid:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "search_mode must be one of %s or %s", searchModeVector, searchModeHybrid)
+	}
+
+	switch vectordb.FusionType(req.Fusion) {
+	case "", vectordb.FusionTypeRRF:
+		if req.VectorWeight != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "vector_weight is supported only by the weighted fusion")
+		}
+		return &vectordb.Fusion{Type: vectordb.FusionTypeRRF}, nil
+	case vectordb.FusionTypeWeighted:
+		if req.VectorWeight < 0 || req.VectorWeight > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "vector_weight must be between 0 and 1")
+		}
+		w := float64(req.VectorWeight)
+		if w == 0 {
+			w = defaultVectorWeight
+		}
+		return &vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: w}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "fusion must be one of %s or %s", vectordb.FusionTypeRRF, vectordb.FusionTypeWeighted)
+	}
+}
+
+// getMMR returns the MMR options. It returns nil if MMR is not requested.
+func getMMR(req *v1.SearchVectorStoreRequest) (*embedder.MMR, error) {
+	if !req.Mmr {
+		if req.MmrLambda != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "mmr_lambda is supported only by mmr")
+		}
+		return nil, nil
+	}
+	if req.MmrLambda < 0 || req.MmrLambda > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "mmr_lambda must be between 0 and 1")
+	}
+	lambda := float64(req.MmrLambda)
+	if lambda == 0 {
+		lambda = defaultMMRLambda
+	}
+	return &embedder.MMR{Lambda: lambda}, nil
+}
+
+// searchError converts an error of the embedder's search to a gRPC status error.
+func searchError(err error) error {
+	if errors.Is(err, embedder.ErrRerankerNotConfigured) {
+		return status.Errorf(codes.FailedPrecondition, "reranking is not enabled in the server")
+	}
+	if errors.Is(err, vectordb.ErrHybridSearchNotSupported) {
+		return status.Errorf(codes.FailedPrecondition, "hybrid search is not supported by the vector database of the server")
+	}
+	return status.Errorf(codes.Internal, "search vector store: %s", err)
+}
+
+func toSearchResultProto(r embedder.SearchResult) *v1.SearchVectorStoreResponse_Result {
+	return &v1.SearchVectorStoreResponse_Result{
+		ChunkId:    r.ChunkID,
+		FileId:     r.FileID,
+		Filename:   r.FileName,
+		Distance:   r.Distance,
+		Score:      r.Score,
+		ChunkIndex: int32(r.ChunkIndex),
+		PageNumber: int32(r.PageNumber),
+		Text:       r.Text,
 	}
-	return &v1.SearchVectorStoreResponse{
-		Documents: docs,
-	}, nil
 }
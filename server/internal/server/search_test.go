@@ -7,15 +7,27 @@ import (
 
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestSearchVectorStore(t *testing.T) {
 	tcs := []struct {
-		name    string
-		req     *v1.SearchVectorStoreRequest
-		resp    *v1.SearchVectorStoreResponse
-		wantErr bool
+		name           string
+		req            *v1.SearchVectorStoreRequest
+		storeRerank    bool
+		resp           *v1.SearchVectorStoreResponse
+		wantHybrid     *vectordb.Fusion
+		wantRerank     bool
+		wantMMR        *embedder.MMR
+		wantMaxPerFile int
+		wantThreshold  float64
+		wantErr        bool
 	}{
 		{
 			name: "found",
@@ -28,6 +40,25 @@ func TestSearchVectorStore(t *testing.T) {
 					"hello",
 					"hi",
 				},
+				Results: []*v1.SearchVectorStoreResponse_Result{
+					{
+						ChunkId:    "1",
+						FileId:     "file0",
+						Filename:   "greetings.pdf",
+						Distance:   0.1,
+						ChunkIndex: 3,
+						PageNumber: 2,
+						Text:       "hello",
+					},
+					{
+						ChunkId:    "2",
+						FileId:     "file1",
+						Filename:   "greetings.txt",
+						Distance:   0.2,
+						ChunkIndex: -1,
+						Text:       "hi",
+					},
+				},
 			},
 			wantErr: false,
 		},
@@ -42,37 +73,327 @@ func TestSearchVectorStore(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "hybrid",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "unknown",
+				SearchMode:    searchModeHybrid,
+			},
+			resp:       &v1.SearchVectorStoreResponse{},
+			wantHybrid: &vectordb.Fusion{Type: vectordb.FusionTypeRRF},
+		},
+		{
+			name: "hybrid with default weight",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "unknown",
+				SearchMode:    searchModeHybrid,
+				Fusion:        string(vectordb.FusionTypeWeighted),
+			},
+			resp:       &v1.SearchVectorStoreResponse{},
+			wantHybrid: &vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: defaultVectorWeight},
+		},
+		{
+			name: "hybrid with weight",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "unknown",
+				SearchMode:    searchModeHybrid,
+				Fusion:        string(vectordb.FusionTypeWeighted),
+				VectorWeight:  0.25,
+			},
+			resp:       &v1.SearchVectorStoreResponse{},
+			wantHybrid: &vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: 0.25},
+		},
+		{
+			name: "rerank",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "unknown",
+				Rerank:        true,
+			},
+			resp:       &v1.SearchVectorStoreResponse{},
+			wantRerank: true,
+		},
+		{
+			name: "rerank by vector store",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "unknown",
+			},
+			storeRerank: true,
+			resp:        &v1.SearchVectorStoreResponse{},
+			wantRerank:  true,
+		},
+		{
+			name: "mmr with default lambda",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "unknown",
+				Mmr:           true,
+			},
+			resp:    &v1.SearchVectorStoreResponse{},
+			wantMMR: &embedder.MMR{Lambda: defaultMMRLambda},
+		},
+		{
+			name: "mmr with lambda and max results per file",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId:     vectorStoreName,
+				Query:             "unknown",
+				Mmr:               true,
+				MmrLambda:         0.25,
+				MaxResultsPerFile: 2,
+			},
+			resp:           &v1.SearchVectorStoreResponse{},
+			wantMMR:        &embedder.MMR{Lambda: 0.25},
+			wantMaxPerFile: 2,
+		},
+		{
+			name: "mmr lambda without mmr",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				MmrLambda:     0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid mmr lambda",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				Mmr:           true,
+				MmrLambda:     1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max results per file",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId:     vectorStoreName,
+				Query:             "hi",
+				MaxResultsPerFile: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "score threshold",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId:  vectorStoreName,
+				Query:          "unknown",
+				ScoreThreshold: 0.5,
+			},
+			resp:          &v1.SearchVectorStoreResponse{},
+			wantThreshold: 0.5,
+		},
+		{
+			name: "score threshold with rrf",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId:  vectorStoreName,
+				Query:          "hi",
+				SearchMode:     searchModeHybrid,
+				ScoreThreshold: 0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "score threshold with rrf and rerank",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId:  vectorStoreName,
+				Query:          "unknown",
+				SearchMode:     searchModeHybrid,
+				Rerank:         true,
+				ScoreThreshold: 0.5,
+			},
+			resp:          &v1.SearchVectorStoreResponse{},
+			wantHybrid:    &vectordb.Fusion{Type: vectordb.FusionTypeRRF},
+			wantRerank:    true,
+			wantThreshold: 0.5,
+		},
+		{
+			name: "score threshold with weighted fusion",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId:  vectorStoreName,
+				Query:          "unknown",
+				SearchMode:     searchModeHybrid,
+				Fusion:         string(vectordb.FusionTypeWeighted),
+				ScoreThreshold: 0.5,
+			},
+			resp:          &v1.SearchVectorStoreResponse{},
+			wantHybrid:    &vectordb.Fusion{Type: vectordb.FusionTypeWeighted, VectorWeight: defaultVectorWeight},
+			wantThreshold: 0.5,
+		},
+		{
+			name: "invalid score threshold",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId:  vectorStoreName,
+				Query:          "hi",
+				ScoreThreshold: 1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown vector store",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: "unknown",
+				Query:         "hi",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid search mode",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				SearchMode:    "keyword",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fusion without hybrid",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				Fusion:        string(vectordb.FusionTypeRRF),
+			},
+			wantErr: true,
+		},
+		{
+			name: "weight with rrf",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				SearchMode:    searchModeHybrid,
+				VectorWeight:  0.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid weight",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				SearchMode:    searchModeHybrid,
+				Fusion:        string(vectordb.FusionTypeWeighted),
+				VectorWeight:  1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid context window",
+			req: &v1.SearchVectorStoreRequest{
+				VectorStoreId: vectorStoreName,
+				Query:         "hi",
+				ContextWindow: maxContextWindow + 1,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:  collectionID,
+				VectorStoreID: vectorStoreName,
+				Name:          collectionName,
+				Status:        store.CollectionStatusCompleted,
+				ProjectID:     "default",
+				Rerank:        tc.storeRerank,
+				MetricType:    string(vectordb.MetricTypeCosine),
+				IndexType:     string(vectordb.IndexTypeHNSW),
+			})
+			assert.NoError(t, err)
 
-			srv := NewInternal(
-				modelName,
-				&noopRetriever{
-					collectionName: vectorStoreName,
-					docs: map[string][]string{
-						"hi": []string{"hello", "hi"},
+			r := &noopRetriever{
+				collectionName: vectorStoreName,
+				results: map[string][]embedder.SearchResult{
+					"hi": {
+						{ChunkID: "1", FileID: "file0", FileName: "greetings.pdf", Distance: 0.1, ChunkIndex: 3, PageNumber: 2, Text: "hello"},
+						{ChunkID: "2", FileID: "file1", FileName: "greetings.txt", Distance: 0.2, ChunkIndex: -1, Text: "hi"},
 					},
 				},
-				testr.New(t),
-			)
+			}
+			srv := NewInternal(st, modelName, r, &noopVStoreClient{}, testr.New(t))
 			ctx := context.Background()
 			resp, err := srv.SearchVectorStore(ctx, tc.req)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
 			assert.NoError(t, err)
-			assert.Equal(t, len(tc.resp.Documents), len(resp.Documents))
+			assert.Equal(t, tc.resp.Documents, resp.Documents)
+			assert.Len(t, resp.Results, len(tc.resp.Results))
+			for i, want := range tc.resp.Results {
+				assert.True(t, proto.Equal(want, resp.Results[i]), "want %v, got %v", want, resp.Results[i])
+			}
+			assert.Equal(t, tc.wantHybrid, r.opts.Hybrid)
+			assert.Equal(t, tc.wantRerank, r.opts.Rerank)
+			assert.Equal(t, tc.wantMMR, r.opts.MMR)
+			assert.Equal(t, tc.wantMaxPerFile, r.opts.MaxResultsPerFile)
+			assert.Equal(t, tc.wantThreshold, r.opts.ScoreThreshold)
+			assert.Equal(t, vectordb.Index{
+				MetricType:     vectordb.MetricTypeCosine,
+				Type:           vectordb.IndexTypeHNSW,
+				M:              16,
+				EFConstruction: 200,
+				EF:             64,
+			}, r.index)
 		})
 	}
 }
 
 type noopRetriever struct {
 	collectionName string
-	docs           map[string][]string
+	results        map[string][]embedder.SearchResult
+	// index and opts are the index and the options of the last search.
+	index vectordb.Index
+	opts  embedder.SearchOptions
 }
 
-func (c *noopRetriever) Search(ctx context.Context, collectionName, modelName, query string, numDocuments int) ([]string, error) {
+func (c *noopRetriever) Search(
+	ctx context.Context,
+	collectionName, modelName string,
+	index vectordb.Index,
+	query string,
+	numDocuments int,
+	opts embedder.SearchOptions,
+) ([]embedder.SearchResult, error) {
 	if collectionName != c.collectionName {
 		return nil, fmt.Errorf("collection %s not found", collectionName)
 	}
-	return c.docs[query], nil
+	c.index = index
+	c.opts = opts
+	return c.results[query], nil
+}
+
+func TestSearchError(t *testing.T) {
+	tcs := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{
+			name: "reranker not configured",
+			err:  embedder.ErrRerankerNotConfigured,
+			want: codes.FailedPrecondition,
+		},
+		{
+			name: "hybrid search not supported",
+			err:  fmt.Errorf("vector search: %w", vectordb.ErrHybridSearchNotSupported),
+			want: codes.FailedPrecondition,
+		},
+		{
+			name: "other",
+			err:  fmt.Errorf("vector search: failed"),
+			want: codes.Internal,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, status.Code(searchError(tc.err)))
+		})
+	}
 }
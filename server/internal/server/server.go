@@ -11,7 +11,9 @@ import (
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
 	"github.com/llmariner/vector-store-manager/server/internal/config"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -36,25 +38,35 @@ type fileInternalClient interface {
 }
 
 type vstoreClient interface {
-	CreateVectorStore(ctx context.Context, name string, dimensions int) (int64, error)
+	CreateVectorStore(ctx context.Context, name string, dimensions int, index vectordb.Index) (int64, error)
+	ValidateIndex(index vectordb.Index, dimensions int) error
 	DeleteVectorStore(ctx context.Context, name string) error
 	ListVectorStores(ctx context.Context) ([]int64, error)
+	UpdateAttributes(ctx context.Context, collectionName, fileID string, attributes map[string]any) error
 }
 
-type embedder interface {
-	AddFile(ctx context.Context, collectionName, modelName, fileID, fileName, filePath string, chunkSizeTokens, chunkOverlapTokens int64) error
+type fileEmbedder interface {
 	DeleteFile(ctx context.Context, collectionName, fileID string) error
+	Search(
+		ctx context.Context,
+		collectionName, modelName string,
+		index vectordb.Index,
+		query string,
+		numDocs int,
+		opts embedder.SearchOptions,
+	) ([]embedder.SearchResult, error)
 }
 
-// New creates a server.
+// New creates a server. The index is the default index of new vector stores.
 func New(
 	store *store.S,
 	fileGetClient fileGetClient,
 	fileInternalClient fileInternalClient,
 	vstoreClient vstoreClient,
-	e embedder,
+	e fileEmbedder,
 	model string,
 	dimensions int,
+	index vectordb.Index,
 	log logr.Logger,
 ) *S {
 	return &S{
@@ -65,6 +77,7 @@ func New(
 		embedder:           e,
 		model:              model,
 		dimensions:         dimensions,
+		index:              index,
 		log:                log.WithName("grpc"),
 	}
 }
@@ -75,7 +88,8 @@ type S struct {
 
 	model      string
 	dimensions int
-	embedder   embedder
+	index      vectordb.Index
+	embedder   fileEmbedder
 
 	fileInternalClient fileInternalClient
 	fileGetClient      fileGetClient
@@ -23,12 +23,23 @@ const (
 	maxMaxChunkSizeTokens     = int64(4096)
 	defaultMaxChunkSizeTokens = int64(800)
 	defaultChunkOverlapTokens = int64(400)
+
+	minBreakpointPercentile     = int32(1)
+	maxBreakpointPercentile     = int32(99)
+	defaultBreakpointPercentile = int32(5)
+
+	// Parent chunks are stored in the database, so they can be longer than other chunks.
+	maxMaxParentChunkSizeTokens     = int64(16384)
+	defaultMaxParentChunkSizeTokens = int64(2000)
+	defaultMaxChildChunkSizeTokens  = int64(200)
 )
 
 type chunkingStrategy struct {
-	maxChunkSizeTokens   int64
-	chunkOverlapTokens   int64
-	chunkingStrategyType store.ChunkingStrategyType
+	maxChunkSizeTokens       int64
+	chunkOverlapTokens       int64
+	breakpointPercentile     int32
+	maxParentChunkSizeTokens int64
+	chunkingStrategyType     store.ChunkingStrategyType
 }
 
 // CreateVectorStoreFile adds a new file to the vector store.
@@ -52,6 +63,13 @@ func (s *S) CreateVectorStoreFile(
 	if err != nil {
 		return nil, err
 	}
+	if err := validateAttributes(req.Attributes); err != nil {
+		return nil, err
+	}
+	attributes, err := marshalAttributes(req.Attributes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
 
 	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
 	if err != nil {
@@ -65,24 +83,22 @@ func (s *S) CreateVectorStoreFile(
 	if err != nil {
 		return nil, err
 	}
-	f, err := s.createVectorStoreFile(ctx, c, file, cs)
+	f, err := s.createVectorStoreFile(ctx, c, file, cs, attributes)
 	if err != nil {
 		return nil, err
 	}
-
-	c, err = s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, c.VectorStoreID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
-	}
-	c.FileCountsCompleted++
-	c.FileCountsTotal++
-	if err := s.store.UpdateCollection(c); err != nil {
-		return nil, status.Errorf(codes.Internal, "update collection: %s", err)
-	}
 	return toVectorStoreFileProto(f), nil
 }
 
-func (s *S) createVectorStoreFile(ctx context.Context, c *store.Collection, f *fv1.File, cs *chunkingStrategy) (*store.File, error) {
+// createVectorStoreFile creates a file in the in_progress status and queues a job that adds the file to the vector store.
+// The file counts of the collection are updated in the same transaction. attributes are the JSON-encoded attributes of the file.
+func (s *S) createVectorStoreFile(
+	ctx context.Context,
+	c *store.Collection,
+	f *fv1.File,
+	cs *chunkingStrategy,
+	attributes []byte,
+) (*store.File, error) {
 	if _, err := s.store.GetFileByFileID(c.VectorStoreID, f.Id); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "file %q already exists in vector store %q", f.Id, c.VectorStoreID)
 	}
@@ -92,57 +108,110 @@ func (s *S) createVectorStoreFile(ctx context.Context, c *store.Collection, f *f
 		return nil, status.Errorf(codes.Internal, "get file path: %s", err)
 	}
 
-	log := s.log.WithValues("file", f.Id, "store", c.VectorStoreID)
-	log.Info("Adding file to vector store")
-	if err := s.embedder.AddFile(
-		ctx,
-		c.VectorStoreID,
-		c.EmbeddingModel,
-		f.Id,
-		f.Filename,
-		resp.Path,
-		cs.maxChunkSizeTokens,
-		cs.chunkOverlapTokens,
-	); err != nil {
-		return nil, status.Errorf(codes.Internal, "add file: %s", err)
-	}
 	file := &store.File{
-		FileID:               f.Id,
-		VectorStoreID:        c.VectorStoreID,
-		UsageBytes:           0,
-		Status:               store.FileStatusCompleted,
-		ChunkingStrategyType: cs.chunkingStrategyType,
-		MaxChunkSizeTokens:   cs.maxChunkSizeTokens,
-		ChunkOverlapTokens:   cs.chunkOverlapTokens,
-	}
-	if err := s.store.CreateFile(file); err != nil {
-		return nil, status.Errorf(codes.Internal, "create file: %s", err)
-	}
-	log.Info("Added file to vector store")
+		FileID:                   f.Id,
+		VectorStoreID:            c.VectorStoreID,
+		UsageBytes:               0,
+		Status:                   store.FileStatusInProgress,
+		ChunkingStrategyType:     cs.chunkingStrategyType,
+		MaxChunkSizeTokens:       cs.maxChunkSizeTokens,
+		ChunkOverlapTokens:       cs.chunkOverlapTokens,
+		BreakpointPercentile:     cs.breakpointPercentile,
+		MaxParentChunkSizeTokens: cs.maxParentChunkSizeTokens,
+		Attributes:               attributes,
+	}
+	job := &store.Job{
+		ProjectID:     c.ProjectID,
+		VectorStoreID: c.VectorStoreID,
+		FileID:        f.Id,
+		FileName:      f.Filename,
+		FilePath:      resp.Path,
+		Status:        store.JobStatusQueued,
+	}
+	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		if err := store.CreateFileInTransaction(tx, file); err != nil {
+			return fmt.Errorf("create file: %s", err)
+		}
+		if err := store.CreateJobInTransaction(tx, job); err != nil {
+			return fmt.Errorf("create job: %s", err)
+		}
+		if err := store.AddFileCountsInTransaction(tx, c.VectorStoreID, store.FileCountsDelta{InProgress: 1, Total: 1}); err != nil {
+			return fmt.Errorf("update file counts: %s", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
+	}
+	s.log.Info("Queued file for vector store", "file", f.Id, "store", c.VectorStoreID)
 	return file, nil
 }
 
 func getChunkingStrategy(cs *v1.ChunkingStrategy) (*chunkingStrategy, error) {
+	if cs == nil || cs.Type == string(store.ChunkingStrategyTypeAuto) {
+		// The splitter and the chunk sizes are chosen by the embedder based on the type of the file.
+		return &chunkingStrategy{
+			chunkingStrategyType: store.ChunkingStrategyTypeAuto,
+		}, nil
+	}
+	if err := validateChunkingStrategy(cs); err != nil {
+		return nil, err
+	}
+	if cs.Type == string(store.ChunkingStrategyTypeSemantic) {
+		ret := &chunkingStrategy{
+			maxChunkSizeTokens:   defaultMaxChunkSizeTokens,
+			breakpointPercentile: defaultBreakpointPercentile,
+			chunkingStrategyType: store.ChunkingStrategyTypeSemantic,
+		}
+		if cs.Semantic != nil {
+			if cs.Semantic.MaxChunkSizeTokens != 0 {
+				ret.maxChunkSizeTokens = cs.Semantic.MaxChunkSizeTokens
+			}
+			if cs.Semantic.BreakpointPercentile != 0 {
+				ret.breakpointPercentile = cs.Semantic.BreakpointPercentile
+			}
+		}
+		return ret, nil
+	}
+	if cs.Type == string(store.ChunkingStrategyTypeParentChild) {
+		ret := &chunkingStrategy{
+			maxChunkSizeTokens:       defaultMaxChildChunkSizeTokens,
+			maxParentChunkSizeTokens: defaultMaxParentChunkSizeTokens,
+			chunkingStrategyType:     store.ChunkingStrategyTypeParentChild,
+		}
+		if cs.ParentChild != nil {
+			if cs.ParentChild.MaxChildChunkSizeTokens != 0 {
+				ret.maxChunkSizeTokens = cs.ParentChild.MaxChildChunkSizeTokens
+			}
+			if cs.ParentChild.MaxParentChunkSizeTokens != 0 {
+				ret.maxParentChunkSizeTokens = cs.ParentChild.MaxParentChunkSizeTokens
+			}
+		}
+		if ret.maxChunkSizeTokens >= ret.maxParentChunkSizeTokens {
+			return nil, status.Errorf(codes.InvalidArgument, "max child chunk size tokens must be less than max parent chunk size tokens")
+		}
+		return ret, nil
+	}
 	ret := &chunkingStrategy{
 		maxChunkSizeTokens:   defaultMaxChunkSizeTokens,
 		chunkOverlapTokens:   defaultChunkOverlapTokens,
 		chunkingStrategyType: store.ChunkingStrategyTypeStatic,
 	}
-	if cs != nil {
-		if err := validateChunkingStrategy(cs); err != nil {
-			return nil, err
-		}
-		if cs.Static != nil {
-			ret.maxChunkSizeTokens = cs.Static.MaxChunkSizeTokens
-			ret.chunkOverlapTokens = cs.Static.ChunkOverlapTokens
-		}
+	if cs.Static != nil {
+		ret.maxChunkSizeTokens = cs.Static.MaxChunkSizeTokens
+		ret.chunkOverlapTokens = cs.Static.ChunkOverlapTokens
 	}
 	return ret, nil
 }
 
 func validateChunkingStrategy(cs *v1.ChunkingStrategy) error {
-	if cs.Type != string(store.ChunkingStrategyTypeAuto) && cs.Type != string(store.ChunkingStrategyTypeStatic) {
-		return status.Errorf(codes.InvalidArgument, "chunking strategy type must be either auto or static")
+	switch store.ChunkingStrategyType(cs.Type) {
+	case store.ChunkingStrategyTypeAuto, store.ChunkingStrategyTypeStatic:
+	case store.ChunkingStrategyTypeSemantic:
+		return validateSemanticChunkingStrategy(cs.Semantic)
+	case store.ChunkingStrategyTypeParentChild:
+		return validateParentChildChunkingStrategy(cs.ParentChild)
+	default:
+		return status.Errorf(codes.InvalidArgument, "chunking strategy type must be one of auto, static, semantic, or parent_child")
 	}
 	if cs.Static == nil {
 		return nil
@@ -193,6 +262,73 @@ func (s *S) GetVectorStoreFile(
 	return toVectorStoreFileProto(f), nil
 }
 
+// UpdateVectorStoreFile updates the attributes of a file in the vector store. The file is not embedded again.
+func (s *S) UpdateVectorStoreFile(
+	ctx context.Context,
+	req *v1.UpdateVectorStoreFileRequest,
+) (*v1.VectorStoreFile, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.VectorStoreId == "" {
+		return nil, status.Error(codes.InvalidArgument, "vector store id is required")
+	}
+	if req.FileId == "" {
+		return nil, status.Error(codes.InvalidArgument, "file id is required")
+	}
+	if err := validateAttributes(req.Attributes); err != nil {
+		return nil, err
+	}
+	attributes, err := marshalAttributes(req.Attributes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	if err := s.validateVectorStore(req.VectorStoreId, userInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
+	f, err := s.store.GetFileByFileID(req.VectorStoreId, req.FileId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "file %q not found in vector store %q", req.FileId, req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get file: %s", err)
+	}
+	if f.Status == store.FileStatusInProgress {
+		// The worker stores the attributes that it read before processing the file.
+		return nil, status.Errorf(codes.FailedPrecondition, "file %q is being processed", req.FileId)
+	}
+
+	// Update the database first so that a concurrent update of the file fails before it changes the
+	// documents.
+	oldAttributes := f.Attributes
+	f.Attributes = attributes
+	if err := s.store.UpdateFileAttributes(f); err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			return nil, status.Errorf(codes.Aborted, "file %q was updated concurrently", req.FileId)
+		}
+		return nil, status.Errorf(codes.Internal, "update file attributes: %s", err)
+	}
+	f.Version++
+
+	if f.Status == store.FileStatusCompleted {
+		if err := s.vstoreClient.UpdateAttributes(ctx, f.VectorStoreID, f.FileID, fromAttributesProto(req.Attributes)); err != nil {
+			// Restore the attributes so that they match the documents that have not been updated. The update of
+			// the documents can be retried.
+			restored := *f
+			restored.Attributes = oldAttributes
+			if rerr := s.store.UpdateFileAttributes(&restored); rerr != nil {
+				s.log.Error(rerr, "Failed to restore file attributes", "file", f.FileID)
+			}
+			return nil, status.Errorf(codes.Internal, "update attributes: %s", err)
+		}
+	}
+	return toVectorStoreFileProto(f), nil
+}
+
 // ListVectorStoreFiles lists files in the vector store.
 func (s *S) ListVectorStoreFiles(
 	ctx context.Context,
@@ -286,29 +422,52 @@ func (s *S) DeleteVectorStoreFile(
 		return nil, err
 	}
 
+	f, err := s.store.GetFileByFileID(req.VectorStoreId, req.FileId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "file %q not found in vector store %q", req.FileId, req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get file: %s", err)
+	}
+
 	// TODO(guangrui): Gracefully handle the deletion error.
 	if err := s.embedder.DeleteFile(ctx, req.VectorStoreId, req.FileId); err != nil {
 		// milvus does not return error if the file does not exist.
 		return nil, status.Errorf(codes.Internal, "embedder delete file: %s", err)
 	}
 
-	if err := s.store.DeleteFile(req.VectorStoreId, req.FileId); err != nil {
+	// Delete the queued job as well if the file has not been processed yet. If a worker
+	// is processing the file, the worker cleans up the documents after it finds that the
+	// file has been deleted.
+	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		if err := store.DeleteFileInTransaction(tx, req.VectorStoreId, req.FileId); err != nil {
+			return err
+		}
+		if err := store.DeleteJobsByFileIDInTransaction(tx, req.VectorStoreId, req.FileId); err != nil {
+			return fmt.Errorf("delete jobs: %s", err)
+		}
+		d := store.FileCountsDelta{Total: -1}
+		switch f.Status {
+		case store.FileStatusInProgress:
+			d.InProgress = -1
+		case store.FileStatusCompleted:
+			d.Completed = -1
+		case store.FileStatusFailed:
+			d.Failed = -1
+		case store.FileStatusCancelled:
+			d.Cancelled = -1
+		}
+		if err := store.AddFileCountsInTransaction(tx, req.VectorStoreId, d); err != nil {
+			return fmt.Errorf("update file counts: %s", err)
+		}
+		return nil
+	}); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "file %q not found in vector store %q", req.FileId, req.VectorStoreId)
 		}
 		return nil, status.Errorf(codes.Internal, "delete file: %s", err)
 	}
 
-	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
-	}
-	c.FileCountsCompleted--
-	c.FileCountsTotal--
-	if err := s.store.UpdateCollection(c); err != nil {
-		return nil, status.Errorf(codes.Internal, "update collection: %s", err)
-	}
-
 	return &v1.DeleteVectorStoreFileResponse{
 		Id:      req.FileId,
 		Object:  vectorStoreFileObject,
@@ -316,6 +475,49 @@ func (s *S) DeleteVectorStoreFile(
 	}, nil
 }
 
+func validateSemanticChunkingStrategy(s *v1.ChunkingStrategy_Semantic) error {
+	if s == nil {
+		return nil
+	}
+	if s.MaxChunkSizeTokens != 0 {
+		if s.MaxChunkSizeTokens < minMaxChunkSizeTokens {
+			return status.Errorf(codes.InvalidArgument, "chunk size tokens must be no less than %d", minMaxChunkSizeTokens)
+		}
+		if s.MaxChunkSizeTokens > maxMaxChunkSizeTokens {
+			return status.Errorf(codes.InvalidArgument, "chunk size tokens must be no more than %d", maxMaxChunkSizeTokens)
+		}
+	}
+	if s.BreakpointPercentile != 0 {
+		if s.BreakpointPercentile < minBreakpointPercentile || s.BreakpointPercentile > maxBreakpointPercentile {
+			return status.Errorf(codes.InvalidArgument, "breakpoint percentile must be between %d and %d", minBreakpointPercentile, maxBreakpointPercentile)
+		}
+	}
+	return nil
+}
+
+func validateParentChildChunkingStrategy(pc *v1.ChunkingStrategy_ParentChild) error {
+	if pc == nil {
+		return nil
+	}
+	if pc.MaxChildChunkSizeTokens != 0 {
+		if pc.MaxChildChunkSizeTokens < minMaxChunkSizeTokens {
+			return status.Errorf(codes.InvalidArgument, "child chunk size tokens must be no less than %d", minMaxChunkSizeTokens)
+		}
+		if pc.MaxChildChunkSizeTokens > maxMaxChunkSizeTokens {
+			return status.Errorf(codes.InvalidArgument, "child chunk size tokens must be no more than %d", maxMaxChunkSizeTokens)
+		}
+	}
+	if pc.MaxParentChunkSizeTokens != 0 {
+		if pc.MaxParentChunkSizeTokens < minMaxChunkSizeTokens {
+			return status.Errorf(codes.InvalidArgument, "parent chunk size tokens must be no less than %d", minMaxChunkSizeTokens)
+		}
+		if pc.MaxParentChunkSizeTokens > maxMaxParentChunkSizeTokens {
+			return status.Errorf(codes.InvalidArgument, "parent chunk size tokens must be no more than %d", maxMaxParentChunkSizeTokens)
+		}
+	}
+	return nil
+}
+
 func toVectorStoreFileProto(f *store.File) *v1.VectorStoreFile {
 	proto := &v1.VectorStoreFile{
 		Id:            f.FileID,
@@ -325,16 +527,34 @@ func toVectorStoreFileProto(f *store.File) *v1.VectorStoreFile {
 		VectorStoreId: f.VectorStoreID,
 		Status:        string(f.Status),
 		ChunkingStrategy: &v1.ChunkingStrategy{
-			Type: string(f.ChunkingStrategyType),
+			Type:     string(f.ChunkingStrategyType),
+			Splitter: f.Splitter,
 		},
 	}
+	// The attributes are validated before they are stored, so they can always be decoded.
+	proto.Attributes, _ = unmarshalAttributesProto(f.Attributes)
 	if f.LastErrorCode != store.LastErrorCodeNone {
 		proto.LastError = &v1.VectorStoreFile_Error{
 			Code:    string(f.LastErrorCode),
 			Message: f.LastErrorMessage,
 		}
 	}
-	if f.ChunkingStrategyType == store.ChunkingStrategyTypeStatic {
+	if f.ChunkingStrategyType == store.ChunkingStrategyTypeSemantic {
+		proto.ChunkingStrategy.Semantic = &v1.ChunkingStrategy_Semantic{
+			BreakpointPercentile: f.BreakpointPercentile,
+			MaxChunkSizeTokens:   f.MaxChunkSizeTokens,
+		}
+		return proto
+	}
+	if f.ChunkingStrategyType == store.ChunkingStrategyTypeParentChild {
+		proto.ChunkingStrategy.ParentChild = &v1.ChunkingStrategy_ParentChild{
+			MaxParentChunkSizeTokens: f.MaxParentChunkSizeTokens,
+			MaxChildChunkSizeTokens:  f.MaxChunkSizeTokens,
+		}
+		return proto
+	}
+	// The chunk sizes of the auto chunking strategy are known only after the file has been processed.
+	if f.MaxChunkSizeTokens > 0 {
 		proto.ChunkingStrategy.Static = &v1.ChunkingStrategy_Static{
 			MaxChunkSizeTokens: f.MaxChunkSizeTokens,
 			ChunkOverlapTokens: f.ChunkOverlapTokens,
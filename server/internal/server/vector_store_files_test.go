@@ -2,14 +2,20 @@ package server
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
 )
 
 const (
@@ -20,9 +26,13 @@ const (
 
 func TestCreateVectorStoreFile(t *testing.T) {
 	tcs := []struct {
-		name    string
-		req     *v1.CreateVectorStoreFileRequest
-		wantErr bool
+		name            string
+		req             *v1.CreateVectorStoreFileRequest
+		wantStatic      *v1.ChunkingStrategy_Static
+		wantSemantic    *v1.ChunkingStrategy_Semantic
+		wantParentChild *v1.ChunkingStrategy_ParentChild
+		wantAttributes  map[string]any
+		wantErr         bool
 	}{
 		{
 			name: "success",
@@ -43,6 +53,159 @@ func TestCreateVectorStoreFile(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "static chunking strategy",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeStatic),
+					Static: &v1.ChunkingStrategy_Static{
+						MaxChunkSizeTokens: 200,
+						ChunkOverlapTokens: 50,
+					},
+				},
+			},
+			wantStatic: &v1.ChunkingStrategy_Static{
+				MaxChunkSizeTokens: 200,
+				ChunkOverlapTokens: 50,
+			},
+			wantErr: false,
+		},
+		{
+			name: "semantic chunking strategy",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeSemantic),
+					Semantic: &v1.ChunkingStrategy_Semantic{
+						BreakpointPercentile: 10,
+					},
+				},
+			},
+			wantSemantic: &v1.ChunkingStrategy_Semantic{
+				BreakpointPercentile: 10,
+				MaxChunkSizeTokens:   800,
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid breakpoint percentile",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeSemantic),
+					Semantic: &v1.ChunkingStrategy_Semantic{
+						BreakpointPercentile: 100,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "parent-child chunking strategy",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeParentChild),
+					ParentChild: &v1.ChunkingStrategy_ParentChild{
+						MaxParentChunkSizeTokens: 8000,
+					},
+				},
+			},
+			wantParentChild: &v1.ChunkingStrategy_ParentChild{
+				MaxParentChunkSizeTokens: 8000,
+				MaxChildChunkSizeTokens:  200,
+			},
+			wantErr: false,
+		},
+		{
+			name: "child chunks larger than parent chunks",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeParentChild),
+					ParentChild: &v1.ChunkingStrategy_ParentChild{
+						MaxParentChunkSizeTokens: 400,
+						MaxChildChunkSizeTokens:  400,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid parent chunk size",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: string(store.ChunkingStrategyTypeParentChild),
+					ParentChild: &v1.ChunkingStrategy_ParentChild{
+						MaxParentChunkSizeTokens: 20000,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid chunking strategy type",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				ChunkingStrategy: &v1.ChunkingStrategy{
+					Type: "unknown",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "attributes",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				Attributes: map[string]*structpb.Value{
+					"author": structpb.NewStringValue("alice"),
+					"year":   structpb.NewNumberValue(2024),
+					"draft":  structpb.NewBoolValue(false),
+				},
+			},
+			wantAttributes: map[string]any{
+				"author": "alice",
+				"year":   2024.0,
+				"draft":  false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "too many attributes",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				Attributes: func() map[string]*structpb.Value {
+					m := map[string]*structpb.Value{}
+					for i := 0; i <= maxMetadataEntries; i++ {
+						m[fmt.Sprintf("key%d", i)] = structpb.NewStringValue("value")
+					}
+					return m
+				}(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid attribute value",
+			req: &v1.CreateVectorStoreFileRequest{
+				FileId:        fileID,
+				VectorStoreId: vectorStoreID,
+				Attributes: map[string]*structpb.Value{
+					"tags": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("a")}}),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid fileID",
 			req: &v1.CreateVectorStoreFileRequest{
@@ -88,6 +251,7 @@ func TestCreateVectorStoreFile(t *testing.T) {
 				},
 				modelName,
 				dimensions,
+				vectordb.Index{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -108,7 +272,43 @@ func TestCreateVectorStoreFile(t *testing.T) {
 			assert.Equal(t, fileID, resp.Id)
 			assert.Equal(t, vectorStoreID, resp.VectorStoreId)
 			assert.Equal(t, vectorStoreFileObject, resp.Object)
-			assert.Equal(t, string(store.ChunkingStrategyTypeStatic), resp.ChunkingStrategy.Type)
+			wantType := store.ChunkingStrategyTypeAuto
+			switch {
+			case tc.wantStatic != nil:
+				wantType = store.ChunkingStrategyTypeStatic
+				assert.Equal(t, tc.wantStatic.MaxChunkSizeTokens, resp.ChunkingStrategy.Static.MaxChunkSizeTokens)
+				assert.Equal(t, tc.wantStatic.ChunkOverlapTokens, resp.ChunkingStrategy.Static.ChunkOverlapTokens)
+			case tc.wantSemantic != nil:
+				wantType = store.ChunkingStrategyTypeSemantic
+				assert.Equal(t, tc.wantSemantic.BreakpointPercentile, resp.ChunkingStrategy.Semantic.BreakpointPercentile)
+				assert.Equal(t, tc.wantSemantic.MaxChunkSizeTokens, resp.ChunkingStrategy.Semantic.MaxChunkSizeTokens)
+				assert.Nil(t, resp.ChunkingStrategy.Static)
+			case tc.wantParentChild != nil:
+				wantType = store.ChunkingStrategyTypeParentChild
+				assert.Equal(t, tc.wantParentChild.MaxParentChunkSizeTokens, resp.ChunkingStrategy.ParentChild.MaxParentChunkSizeTokens)
+				assert.Equal(t, tc.wantParentChild.MaxChildChunkSizeTokens, resp.ChunkingStrategy.ParentChild.MaxChildChunkSizeTokens)
+				assert.Nil(t, resp.ChunkingStrategy.Static)
+			default:
+				// The chunk sizes of the auto chunking strategy are resolved when the file is processed.
+				assert.Nil(t, resp.ChunkingStrategy.Static)
+			}
+			assert.Equal(t, string(wantType), resp.ChunkingStrategy.Type)
+			assert.Equal(t, string(store.FileStatusInProgress), resp.Status)
+			if tc.wantAttributes != nil {
+				assert.Equal(t, tc.wantAttributes, (&structpb.Struct{Fields: resp.Attributes}).AsMap())
+			} else {
+				assert.Empty(t, resp.Attributes)
+			}
+
+			job, err := st.GetJobByFileID(vectorStoreID, fileID)
+			assert.NoError(t, err)
+			assert.Equal(t, store.JobStatusQueued, job.Status)
+			assert.Equal(t, "test.txt", job.FilePath)
+
+			c, err := st.GetCollectionByVectorStoreID("default", vectorStoreID)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), c.FileCountsInProgress)
+			assert.Equal(t, int64(1), c.FileCountsTotal)
 		})
 	}
 }
@@ -139,6 +339,7 @@ func TestCreateVectorStoreFile_AlreadyExists(t *testing.T) {
 		},
 		modelName,
 		dimensions,
+		vectordb.Index{},
 		testr.New(t),
 	)
 	err := st.CreateCollection(&store.Collection{
@@ -256,6 +457,7 @@ func TestListVectorStoreFiles(t *testing.T) {
 				},
 				modelName,
 				dimensions,
+				vectordb.Index{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -276,7 +478,7 @@ func TestListVectorStoreFiles(t *testing.T) {
 				assert.Equal(t, f, resp.Id)
 				assert.Equal(t, vectorStoreID, resp.VectorStoreId)
 				assert.Equal(t, vectorStoreFileObject, resp.Object)
-				assert.Equal(t, string(store.ChunkingStrategyTypeStatic), resp.ChunkingStrategy.Type)
+				assert.Equal(t, string(store.ChunkingStrategyTypeAuto), resp.ChunkingStrategy.Type)
 			}
 
 			respList, err := srv.ListVectorStoreFiles(ctx, tc.req)
@@ -360,6 +562,7 @@ func TestGetVectorStoreFile(t *testing.T) {
 				},
 				modelName,
 				dimensions,
+				vectordb.Index{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -391,6 +594,179 @@ func TestGetVectorStoreFile(t *testing.T) {
 	}
 }
 
+func TestUpdateVectorStoreFile(t *testing.T) {
+	tcs := []struct {
+		name           string
+		status         store.FileStatus
+		req            *v1.UpdateVectorStoreFileRequest
+		vstoreErr      error
+		wantAttributes map[string]any
+		// wantUpdated is true if the documents in the vector store are updated.
+		wantUpdated bool
+		wantCode    codes.Code
+	}{
+		{
+			name:   "completed",
+			status: store.FileStatusCompleted,
+			req: &v1.UpdateVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+				Attributes: map[string]*structpb.Value{
+					"department":  structpb.NewStringValue("support"),
+					"valid_until": structpb.NewNumberValue(1767225600),
+				},
+			},
+			wantAttributes: map[string]any{
+				"department":  "support",
+				"valid_until": 1767225600.0,
+			},
+			wantUpdated: true,
+		},
+		{
+			name:   "clear",
+			status: store.FileStatusCompleted,
+			req: &v1.UpdateVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+			},
+			wantAttributes: map[string]any{},
+			wantUpdated:    true,
+		},
+		{
+			name:   "failed",
+			status: store.FileStatusFailed,
+			req: &v1.UpdateVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+				Attributes: map[string]*structpb.Value{
+					"department": structpb.NewStringValue("support"),
+				},
+			},
+			wantAttributes: map[string]any{
+				"department": "support",
+			},
+		},
+		{
+			name:   "vector store failure",
+			status: store.FileStatusCompleted,
+			req: &v1.UpdateVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+				Attributes: map[string]*structpb.Value{
+					"department": structpb.NewStringValue("support"),
+				},
+			},
+			vstoreErr: fmt.Errorf("unavailable"),
+			// The attributes are restored.
+			wantAttributes: map[string]any{
+				"department": "sales",
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:   "in progress",
+			status: store.FileStatusInProgress,
+			req: &v1.UpdateVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:   "too long value",
+			status: store.FileStatusCompleted,
+			req: &v1.UpdateVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+				Attributes: map[string]*structpb.Value{
+					"department": structpb.NewStringValue(strings.Repeat("a", maxMetadataValueLength+1)),
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:   "file not found",
+			status: store.FileStatusCompleted,
+			req: &v1.UpdateVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        "unknown",
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			vs := &noopVStoreClient{
+				vs: map[string]int64{
+					vectorStoreID: collectionID,
+				},
+				updateAttributesErr: tc.vstoreErr,
+			}
+			srv := New(
+				st,
+				&noopFileGetClient{},
+				&noopFileInternalClient{},
+				vs,
+				&noopEmbedder{
+					collectionName: vectorStoreID,
+				},
+				modelName,
+				dimensions,
+				vectordb.Index{},
+				testr.New(t),
+			)
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:  collectionID,
+				VectorStoreID: vectorStoreID,
+				Name:          collectionName,
+				Status:        store.CollectionStatusCompleted,
+				ProjectID:     "default",
+			})
+			assert.NoError(t, err)
+			err = st.CreateFile(&store.File{
+				VectorStoreID: vectorStoreID,
+				FileID:        fileID,
+				Status:        tc.status,
+				Attributes:    []byte(`{"department":"sales"}`),
+			})
+			assert.NoError(t, err)
+
+			resp, err := srv.UpdateVectorStoreFile(fakeAuthInto(context.Background()), tc.req)
+			if tc.wantCode != codes.OK {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				if tc.wantAttributes != nil {
+					f, err := st.GetFileByFileID(vectorStoreID, fileID)
+					assert.NoError(t, err)
+					var got map[string]any
+					assert.NoError(t, json.Unmarshal(f.Attributes, &got))
+					assert.Equal(t, tc.wantAttributes, got)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantAttributes, (&structpb.Struct{Fields: resp.Attributes}).AsMap())
+
+			if tc.wantUpdated {
+				assert.Equal(t, tc.wantAttributes, vs.attributes[fileID])
+			} else {
+				assert.Empty(t, vs.attributes)
+			}
+
+			got, err := srv.GetVectorStoreFile(fakeAuthInto(context.Background()), &v1.GetVectorStoreFileRequest{
+				VectorStoreId: vectorStoreID,
+				FileId:        fileID,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantAttributes, (&structpb.Struct{Fields: got.Attributes}).AsMap())
+		})
+	}
+}
+
 func TestDeleteVectorStoreFile(t *testing.T) {
 	tcs := []struct {
 		name    string
@@ -449,6 +825,7 @@ func TestDeleteVectorStoreFile(t *testing.T) {
 				},
 				modelName,
 				dimensions,
+				vectordb.Index{},
 				testr.New(t),
 			)
 			err := st.CreateCollection(&store.Collection{
@@ -476,6 +853,14 @@ func TestDeleteVectorStoreFile(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tc.resp.Id, respDelete.Id)
 			assert.Equal(t, tc.resp.Deleted, respDelete.Deleted)
+
+			_, err = st.GetJobByFileID(vectorStoreID, fileID)
+			assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+			c, err := st.GetCollectionByVectorStoreID("default", vectorStoreID)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), c.FileCountsInProgress)
+			assert.Equal(t, int64(0), c.FileCountsTotal)
 		})
 	}
 }
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	vectorStoreSearchResultsObject = "vector_store.search_results.page"
+	searchResultContentTypeText    = "text"
+
+	defaultMaxNumResults = 10
+	maxMaxNumResults     = 50
+)
+
+// rankers are the supported rankers. The results are always ranked by the similarity to the query.
+var rankers = map[string]bool{
+	"":                   true,
+	"auto":               true,
+	"default-2024-11-15": true,
+}
+
+// SearchVectorStore searches a vector store for the chunks that are relevant to the query.
+func (s *S) SearchVectorStore(
+	ctx context.Context,
+	req *v1.VectorStoreSearchRequest,
+) (*v1.VectorStoreSearchResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.VectorStoreId == "" {
+		return nil, status.Error(codes.InvalidArgument, "vector_store_id is required")
+	}
+	if req.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if req.MaxNumResults < 0 || req.MaxNumResults > maxMaxNumResults {
+		return nil, status.Errorf(codes.InvalidArgument, "max_num_results must be between 1 and %d", maxMaxNumResults)
+	}
+	var filter *vectordb.Filter
+	if req.Filters != nil {
+		var err error
+		if filter, err = toFilter(req.Filters); err != nil {
+			return nil, err
+		}
+	}
+	var scoreThreshold float64
+	if ro := req.RankingOptions; ro != nil {
+		if !rankers[ro.Ranker] {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported ranker %q", ro.Ranker)
+		}
+		if ro.ScoreThreshold < 0 || ro.ScoreThreshold > 1 {
+			return nil, status.Error(codes.InvalidArgument, "score_threshold must be between 0 and 1")
+		}
+		scoreThreshold = ro.ScoreThreshold
+	}
+
+	c, err := s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, req.VectorStoreId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "vector store %q not found", req.VectorStoreId)
+		}
+		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
+	}
+
+	model := c.EmbeddingModel
+	if model == "" {
+		model = s.model
+	}
+	numResults := int(req.MaxNumResults)
+	if numResults == 0 {
+		numResults = defaultMaxNumResults
+	}
+	results, err := s.embedder.Search(ctx, c.VectorStoreID, model, collectionIndex(c), req.Query, numResults, embedder.SearchOptions{
+		Filter:          filter,
+		Rerank:          c.Rerank,
+		ScoreThreshold:  scoreThreshold,
+		LegacyEmbedding: !c.BatchEmbeddings,
+	})
+	if err != nil {
+		return nil, searchError(err)
+	}
+
+	resp := &v1.VectorStoreSearchResponse{
+		Object:      vectorStoreSearchResultsObject,
+		SearchQuery: []string{req.Query},
+	}
+	for _, r := range results {
+		resp.Data = append(resp.Data, &v1.VectorStoreSearchResult{
+			FileId:     r.FileID,
+			Filename:   r.FileName,
+			Score:      float64(r.Score),
+			Attributes: toAttributesProto(r.Attributes),
+			Content: []*v1.VectorStoreSearchResult_Content{
+				{
+					Type: searchResultContentTypeText,
+					Text: r.Text,
+				},
+			},
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,283 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestSearchVectorStore_Public(t *testing.T) {
+	tcs := []struct {
+		name        string
+		req         *v1.VectorStoreSearchRequest
+		storeRerank bool
+		resp        *v1.VectorStoreSearchResponse
+		wantFilter  *vectordb.Filter
+		wantCode    codes.Code
+	}{
+		{
+			name: "found",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hi",
+			},
+			resp: &v1.VectorStoreSearchResponse{
+				Object:      vectorStoreSearchResultsObject,
+				SearchQuery: []string{"hi"},
+				Data: []*v1.VectorStoreSearchResult{
+					{
+						FileId:   "file0",
+						Filename: "greetings.pdf",
+						Score:    0.75,
+						Content:  []*v1.VectorStoreSearchResult_Content{{Type: "text", Text: "hello"}},
+					},
+					{
+						FileId:   "file1",
+						Filename: "greetings.txt",
+						Score:    0.5,
+						Content:  []*v1.VectorStoreSearchResult_Content{{Type: "text", Text: "hi"}},
+					},
+				},
+			},
+		},
+		{
+			name: "max num results",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hi",
+				MaxNumResults: 1,
+			},
+			resp: &v1.VectorStoreSearchResponse{
+				Object:      vectorStoreSearchResultsObject,
+				SearchQuery: []string{"hi"},
+				Data: []*v1.VectorStoreSearchResult{
+					{
+						FileId:   "file0",
+						Filename: "greetings.pdf",
+						Score:    0.75,
+						Content:  []*v1.VectorStoreSearchResult_Content{{Type: "text", Text: "hello"}},
+					},
+				},
+			},
+		},
+		{
+			name: "score threshold",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hi",
+				RankingOptions: &v1.VectorStoreSearchRankingOptions{
+					Ranker:         "auto",
+					ScoreThreshold: 0.6,
+				},
+			},
+			resp: &v1.VectorStoreSearchResponse{
+				Object:      vectorStoreSearchResultsObject,
+				SearchQuery: []string{"hi"},
+				Data: []*v1.VectorStoreSearchResult{
+					{
+						FileId:   "file0",
+						Filename: "greetings.pdf",
+						Score:    0.75,
+						Content:  []*v1.VectorStoreSearchResult_Content{{Type: "text", Text: "hello"}},
+					},
+				},
+			},
+		},
+		{
+			name: "filter",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "greetings",
+				Filters: &v1.VectorStoreSearchFilter{
+					Type: "and",
+					Filters: []*v1.VectorStoreSearchFilter{
+						{Type: "eq", Key: "author", Value: structpb.NewStringValue("alice")},
+						{Type: "gte", Key: "year", Value: structpb.NewNumberValue(2024)},
+					},
+				},
+			},
+			resp: &v1.VectorStoreSearchResponse{
+				Object:      vectorStoreSearchResultsObject,
+				SearchQuery: []string{"greetings"},
+				Data: []*v1.VectorStoreSearchResult{
+					{
+						FileId:   "file0",
+						Filename: "greetings.pdf",
+						Score:    0.75,
+						Attributes: map[string]*structpb.Value{
+							"author": structpb.NewStringValue("alice"),
+							"year":   structpb.NewNumberValue(2024),
+						},
+						Content: []*v1.VectorStoreSearchResult_Content{{Type: "text", Text: "hello"}},
+					},
+				},
+			},
+			wantFilter: &vectordb.Filter{
+				Type: vectordb.FilterTypeAnd,
+				Filters: []*vectordb.Filter{
+					{Type: vectordb.FilterTypeEq, Key: "author", Value: "alice"},
+					{Type: vectordb.FilterTypeGte, Key: "year", Value: 2024.0},
+				},
+			},
+		},
+		{
+			name: "rerank by vector store",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "reranked",
+			},
+			storeRerank: true,
+			resp: &v1.VectorStoreSearchResponse{
+				Object:      vectorStoreSearchResultsObject,
+				SearchQuery: []string{"reranked"},
+				Data: []*v1.VectorStoreSearchResult{
+					{
+						FileId:   "file1",
+						Filename: "greetings.txt",
+						Score:    0.75,
+						Content:  []*v1.VectorStoreSearchResult_Content{{Type: "text", Text: "hi"}},
+					},
+				},
+			},
+		},
+		{
+			name: "no results",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "unknown",
+			},
+			resp: &v1.VectorStoreSearchResponse{
+				Object:      vectorStoreSearchResultsObject,
+				SearchQuery: []string{"unknown"},
+			},
+		},
+		{
+			name: "unknown vector store",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: "unknown",
+				Query:         "hi",
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "no query",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "too many results",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hi",
+				MaxNumResults: maxMaxNumResults + 1,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unsupported ranker",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId:  vectorStoreID,
+				Query:          "hi",
+				RankingOptions: &v1.VectorStoreSearchRankingOptions{Ranker: "unknown"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid score threshold",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId:  vectorStoreID,
+				Query:          "hi",
+				RankingOptions: &v1.VectorStoreSearchRankingOptions{ScoreThreshold: 1.5},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid filter",
+			req: &v1.VectorStoreSearchRequest{
+				VectorStoreId: vectorStoreID,
+				Query:         "hi",
+				Filters: &v1.VectorStoreSearchFilter{
+					Type:  "gt",
+					Key:   "author",
+					Value: structpb.NewStringValue("alice"),
+				},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			e := &noopEmbedder{
+				collectionName: vectorStoreID,
+				results: map[string][]embedder.SearchResult{
+					"hi": {
+						{ChunkID: "1", FileID: "file0", FileName: "greetings.pdf", Distance: 0.25, Score: 0.75, Text: "hello"},
+						{ChunkID: "2", FileID: "file1", FileName: "greetings.txt", Distance: 1, Score: 0.5, Text: "hi"},
+					},
+					"reranked": {
+						{ChunkID: "2", FileID: "file1", FileName: "greetings.txt", Distance: 1, Score: 0.75, Text: "hi"},
+					},
+					"greetings": {
+						{
+							ChunkID:    "1",
+							FileID:     "file0",
+							FileName:   "greetings.pdf",
+							Distance:   0.25,
+							Score:      0.75,
+							Text:       "hello",
+							Attributes: map[string]any{"author": "alice", "year": 2024.0},
+						},
+					},
+				},
+			}
+			srv := New(
+				st,
+				&noopFileGetClient{},
+				&noopFileInternalClient{},
+				&noopVStoreClient{},
+				e,
+				modelName,
+				dimensions,
+				vectordb.Index{},
+				testr.New(t),
+			)
+			err := st.CreateCollection(&store.Collection{
+				CollectionID:   collectionID,
+				VectorStoreID:  vectorStoreID,
+				Name:           collectionName,
+				Status:         store.CollectionStatusCompleted,
+				ProjectID:      "default",
+				EmbeddingModel: modelName,
+				Rerank:         tc.storeRerank,
+			})
+			assert.NoError(t, err)
+
+			resp, err := srv.SearchVectorStore(fakeAuthInto(context.Background()), tc.req)
+			if tc.wantCode != codes.OK {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tc.resp, resp), "want %v, got %v", tc.resp, resp)
+			assert.Equal(t, tc.wantFilter, e.filter)
+			assert.Equal(t, tc.storeRerank, e.rerank)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -42,6 +43,14 @@ func (s *S) CreateVectorStore(
 		return nil, err
 	}
 
+	index := overrideIndex(s.index, req.Index)
+	if req.MetricType != "" {
+		index.MetricType = vectordb.MetricType(req.MetricType)
+	}
+	if err := s.vstoreClient.ValidateIndex(index, s.dimensions); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid index: %s", err)
+	}
+
 	// Pass the Authorization to the context for downstream gRPC calls.
 	ctx = auth.CarryMetadata(ctx)
 
@@ -67,7 +76,7 @@ func (s *S) CreateVectorStore(
 		return nil, status.Errorf(codes.Internal, "generate id: %s", err)
 	}
 
-	cid, err := s.vstoreClient.CreateVectorStore(ctx, vsID, s.dimensions)
+	cid, err := s.vstoreClient.CreateVectorStore(ctx, vsID, s.dimensions, index)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +95,10 @@ func (s *S) CreateVectorStore(
 		LastActiveAt:        time.Now().Unix(),
 		EmbeddingModel:      s.model,
 		EmbeddingDimensions: s.dimensions,
+		Rerank:              req.Rerank,
+		BatchEmbeddings:     true,
 	}
+	setCollectionIndex(c, index)
 	if ea := req.ExpiresAfter; ea != nil {
 		if err := validateExpiresAfter(ea); err != nil {
 			return nil, err
@@ -124,26 +136,19 @@ func (s *S) CreateVectorStore(
 		return nil, err
 	}
 
-	fileCompleted := int64(0)
 	var errMsgs []string
 	for _, f := range fs {
-		if _, err := s.createVectorStoreFile(ctx, c, f, cs); err != nil {
+		if _, err := s.createVectorStoreFile(ctx, c, f, cs, nil /* attributes */); err != nil {
 			s.log.Error(err, "Failed to add file to vector store", "file", f.Id, "store", c.VectorStoreID)
 			errMsgs = append(errMsgs, fmt.Sprintf("file %q: %s", f.Id, err))
 			continue
 		}
-		fileCompleted++
 	}
 
 	c, err = s.store.GetCollectionByVectorStoreID(userInfo.ProjectID, c.VectorStoreID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "get collection: %s", err)
 	}
-	c.FileCountsCompleted += fileCompleted
-	c.FileCountsTotal += fileCompleted
-	if err := s.store.UpdateCollection(c); err != nil {
-		return nil, status.Errorf(codes.Internal, "update collection: %s", err)
-	}
 
 	vsProto := toVectorStoreProto(c, cms)
 	if len(errMsgs) > 0 {
@@ -440,6 +445,12 @@ func (s *S) DeleteVectorStore(
 		if err := store.DeleteAllFilesByVectorStoreIDInTransaction(tx, req.Id); err != nil {
 			return fmt.Errorf("delete files: %s", err)
 		}
+		if err := store.DeleteAllJobsByVectorStoreIDInTransaction(tx, req.Id); err != nil {
+			return fmt.Errorf("delete jobs: %s", err)
+		}
+		if err := store.DeleteAllParentChunksByVectorStoreIDInTransaction(tx, req.Id); err != nil {
+			return fmt.Errorf("delete parent chunks: %s", err)
+		}
 		return nil
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "transaction: %s", err)
@@ -465,6 +476,7 @@ func toVectorStoreProto(c *store.Collection, cms []*store.CollectionMetadata) *v
 		m[cm.Key] = cm.Value
 	}
 
+	index := collectionIndex(c)
 	return &v1.VectorStore{
 		Id:         c.VectorStoreID,
 		Object:     vectorStoreObject,
@@ -486,6 +498,9 @@ func toVectorStoreProto(c *store.Collection, cms []*store.CollectionMetadata) *v
 		ExpiresAt:    c.ExpiresAt,
 		LastActiveAt: c.LastActiveAt,
 		Metadata:     m,
+		Rerank:       c.Rerank,
+		MetricType:   string(index.MetricType),
+		Index:        toVectorIndexProto(index),
 	}
 }
 
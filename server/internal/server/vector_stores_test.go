@@ -8,11 +8,14 @@ import (
 	"github.com/go-logr/logr/testr"
 	fv1 "github.com/llmariner/file-manager/api/v1"
 	v1 "github.com/llmariner/vector-store-manager/api/v1"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
 	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -25,9 +28,10 @@ const (
 
 func TestCreateVectorStore(t *testing.T) {
 	tcs := []struct {
-		name    string
-		req     *v1.CreateVectorStoreRequest
-		wantErr bool
+		name      string
+		req       *v1.CreateVectorStoreRequest
+		wantIndex *v1.VectorIndex
+		wantErr   bool
 	}{
 		{
 			name: "success",
@@ -44,6 +48,39 @@ func TestCreateVectorStore(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success with rerank",
+			req: &v1.CreateVectorStoreRequest{
+				Name:   vectorStoreName,
+				Rerank: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "success with metric type",
+			req: &v1.CreateVectorStoreRequest{
+				Name:       vectorStoreName,
+				MetricType: string(vectordb.MetricTypeCosine),
+			},
+			wantErr: false,
+		},
+		{
+			name: "success with index",
+			req: &v1.CreateVectorStoreRequest{
+				Name: vectorStoreName,
+				Index: &v1.VectorIndex{
+					Type: string(vectordb.IndexTypeHNSW),
+					M:    32,
+				},
+			},
+			wantIndex: &v1.VectorIndex{
+				Type:           string(vectordb.IndexTypeHNSW),
+				M:              32,
+				EfConstruction: 200,
+				Ef:             64,
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid file",
 			req: &v1.CreateVectorStoreRequest{
@@ -52,6 +89,24 @@ func TestCreateVectorStore(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid metric type",
+			req: &v1.CreateVectorStoreRequest{
+				Name:       vectorStoreName,
+				MetricType: "hamming",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid index",
+			req: &v1.CreateVectorStoreRequest{
+				Name: vectorStoreName,
+				Index: &v1.VectorIndex{
+					Type: "annoy",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -77,6 +132,7 @@ func TestCreateVectorStore(t *testing.T) {
 				&noopEmbedder{},
 				modelName,
 				dimensions,
+				vectordb.Index{},
 				testr.New(t),
 			)
 			ctx := fakeAuthInto(context.Background())
@@ -89,7 +145,22 @@ func TestCreateVectorStore(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, vectorStoreName, resp.Name)
 			assert.Equal(t, int64(len(tc.req.FileIds)), resp.FileCounts.Total)
-			assert.Equal(t, int64(len(tc.req.FileIds)), resp.FileCounts.Completed)
+			assert.Equal(t, int64(len(tc.req.FileIds)), resp.FileCounts.InProgress)
+			assert.Equal(t, tc.req.Rerank, resp.Rerank)
+			wantMetricType := tc.req.MetricType
+			if wantMetricType == "" {
+				wantMetricType = string(vectordb.MetricTypeL2)
+			}
+			assert.Equal(t, wantMetricType, resp.MetricType)
+			wantIndex := tc.wantIndex
+			if wantIndex == nil {
+				wantIndex = &v1.VectorIndex{
+					Type:   string(vectordb.IndexTypeIVFFlat),
+					Nlist:  128,
+					Nprobe: 16,
+				}
+			}
+			assert.True(t, proto.Equal(wantIndex, resp.Index), "want %v, got %v", wantIndex, resp.Index)
 		})
 	}
 }
@@ -122,6 +193,7 @@ func TestListVectorStores(t *testing.T) {
 		&noopEmbedder{},
 		modelName,
 		dimensions,
+		vectordb.Index{},
 		testr.New(t),
 	)
 
@@ -215,6 +287,7 @@ func TestGetVectorStore(t *testing.T) {
 		&noopEmbedder{},
 		modelName,
 		dimensions,
+		vectordb.Index{},
 		testr.New(t),
 	)
 
@@ -287,6 +360,7 @@ func TestGetVectorStoreByName(t *testing.T) {
 		&noopEmbedder{},
 		modelName,
 		dimensions,
+		vectordb.Index{},
 		testr.New(t),
 	)
 
@@ -405,6 +479,7 @@ func TestDeleteVectorStore(t *testing.T) {
 				&noopEmbedder{},
 				modelName,
 				dimensions,
+				vectordb.Index{},
 				testr.New(t),
 			)
 			ctx := fakeAuthInto(context.Background())
@@ -495,6 +570,7 @@ func TestUpdateVectorStore(t *testing.T) {
 				&noopEmbedder{},
 				modelName,
 				dimensions,
+				vectordb.Index{},
 				testr.New(t),
 			)
 			ctx := fakeAuthInto(context.Background())
@@ -545,14 +621,33 @@ func (c *noopFileInternalClient) GetFilePath(ctx context.Context, in *fv1.GetFil
 
 type noopVStoreClient struct {
 	vs map[string]int64
+	// attributes are the attributes updated by UpdateAttributes keyed by file ID.
+	attributes map[string]map[string]any
+	// updateAttributesErr is returned by UpdateAttributes if it is not nil.
+	updateAttributesErr error
+	// index is the index of the last created vector store or the last rebuilt index.
+	index vectordb.Index
 }
 
-func (c *noopVStoreClient) CreateVectorStore(ctx context.Context, name string, dimensions int) (int64, error) {
+func (c *noopVStoreClient) CreateVectorStore(ctx context.Context, name string, dimensions int, index vectordb.Index) (int64, error) {
 	newID := int64(len(c.vs) + 1)
 	c.vs[name] = newID
+	c.index = index
 	return newID, nil
 }
 
+func (c *noopVStoreClient) ValidateIndex(index vectordb.Index, dimensions int) error {
+	return index.Validate(dimensions)
+}
+
+func (c *noopVStoreClient) RebuildIndex(ctx context.Context, name string, index vectordb.Index) error {
+	if _, ok := c.vs[name]; !ok {
+		return status.Error(codes.NotFound, "name not found")
+	}
+	c.index = index
+	return nil
+}
+
 func (c *noopVStoreClient) DeleteVectorStore(ctx context.Context, name string) error {
 	for k := range c.vs {
 		if k == name {
@@ -564,6 +659,20 @@ func (c *noopVStoreClient) DeleteVectorStore(ctx context.Context, name string) e
 	return status.Error(codes.NotFound, "name not found")
 }
 
+func (c *noopVStoreClient) UpdateAttributes(ctx context.Context, collectionName, fileID string, attributes map[string]any) error {
+	if c.updateAttributesErr != nil {
+		return c.updateAttributesErr
+	}
+	if _, ok := c.vs[collectionName]; !ok {
+		return status.Error(codes.NotFound, "name not found")
+	}
+	if c.attributes == nil {
+		c.attributes = map[string]map[string]any{}
+	}
+	c.attributes[fileID] = attributes
+	return nil
+}
+
 func (c *noopVStoreClient) ListVectorStores(ctx context.Context) ([]int64, error) {
 	var ids []int64
 	for _, id := range c.vs {
@@ -574,18 +683,40 @@ func (c *noopVStoreClient) ListVectorStores(ctx context.Context) ([]int64, error
 
 type noopEmbedder struct {
 	collectionName string
+	results        map[string][]embedder.SearchResult
+	// filter and rerank are the options of the last search.
+	filter *vectordb.Filter
+	rerank bool
 }
 
-func (c *noopEmbedder) AddFile(ctx context.Context, collectionName, modelName, fileID, fileName, filePath string, chunkSizeTokens, chunkOverlapTokens int64) error {
+func (c *noopEmbedder) DeleteFile(ctx context.Context, collectionName, fileID string) error {
 	if c.collectionName == "" || collectionName == c.collectionName {
 		return nil
 	}
 	return fmt.Errorf("collection %s not found", collectionName)
 }
 
-func (c *noopEmbedder) DeleteFile(ctx context.Context, collectionName, fileID string) error {
-	if c.collectionName == "" || collectionName == c.collectionName {
-		return nil
+func (c *noopEmbedder) Search(
+	ctx context.Context,
+	collectionName, modelName string,
+	index vectordb.Index,
+	query string,
+	numDocs int,
+	opts embedder.SearchOptions,
+) ([]embedder.SearchResult, error) {
+	if collectionName != c.collectionName {
+		return nil, fmt.Errorf("collection %s not found", collectionName)
 	}
-	return fmt.Errorf("collection %s not found", collectionName)
+	c.filter = opts.Filter
+	c.rerank = opts.Rerank
+	var rs []embedder.SearchResult
+	for _, r := range c.results[query] {
+		if float64(r.Score) >= opts.ScoreThreshold {
+			rs = append(rs, r)
+		}
+	}
+	if len(rs) > numDocs {
+		rs = rs[:numDocs]
+	}
+	return rs, nil
 }
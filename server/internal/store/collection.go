@@ -34,10 +34,10 @@ type Collection struct {
 	ProjectID      string `gorm:"uniqueIndex:idx_collection_project_id_name"`
 
 	// VectorStoreID is the ID of the vector store that is externally visible in the API.
-	// This is also used as the name of the Milvus collection.
+	// This is also used as the name of the collection in the vector database.
 	VectorStoreID string `gorm:"uniqueIndex"`
 
-	// CollectionID is the ID of the Milvus collection.
+	// CollectionID is the ID of the collection in the vector database.
 	CollectionID int64 `gorm:"uniqueIndex"`
 
 	Name string `gorm:"uniqueIndex:idx_collection_project_id_name"`
@@ -65,6 +65,28 @@ type Collection struct {
 
 	EmbeddingModel      string
 	EmbeddingDimensions int
+	// MetricType is the metric that measures the similarity between vectors. It is empty for collections
+	// created by older versions, which use L2.
+	MetricType string
+	// BatchEmbeddings is true if the texts of the collection are embedded with the batch embedding API of the
+	// LLM engine. It is false for collections created by older versions, which embed texts with the legacy
+	// API one at a time. Ollama normalizes the embeddings of the batch API but not those of the legacy API, so
+	// the documents and the queries of a collection keep being embedded with the same API.
+	BatchEmbeddings bool
+
+	// IndexType and the index parameters configure the index of the vectors. They are empty for collections
+	// created by older versions, which use IVF_FLAT with the default parameters.
+	IndexType           string
+	IndexNList          int
+	IndexNProbe         int
+	IndexM              int
+	IndexNBits          int
+	IndexEFConstruction int
+	IndexEF             int
+	IndexSearchList     int
+
+	// Rerank specifies whether search results are reranked by default.
+	Rerank bool
 
 	Version int
 }
@@ -91,6 +113,16 @@ func (s *S) GetCollectionByVectorStoreID(projectID string, vectorStoreID string)
 	return &c, nil
 }
 
+// GetCollectionByVectorStoreIDInternal gets a collection in any project. It is used by internal
+// servers that do not have user info.
+func (s *S) GetCollectionByVectorStoreIDInternal(vectorStoreID string) (*Collection, error) {
+	var c Collection
+	if err := s.db.Where("vector_store_id = ?", vectorStoreID).Take(&c).Error; err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
 // GetCollectionByName gets a collection.
 func (s *S) GetCollectionByName(projectID, name string) (*Collection, error) {
 	var c Collection
@@ -179,6 +211,64 @@ func UpdateCollectionInTransaction(tx *gorm.DB, nc *Collection) error {
 	return nil
 }
 
+// FileCountsDelta is a change of the file counts of a collection.
+type FileCountsDelta struct {
+	InProgress int64
+	Completed  int64
+	Failed     int64
+	Cancelled  int64
+	Total      int64
+}
+
+// AddFileCountsInTransaction adds the delta to the file counts of the collection. The counts are updated in
+// place regardless of the version so that the update does not conflict with the workers, and the version is
+// incremented so that concurrent updates with the old counts fail.
+func AddFileCountsInTransaction(tx *gorm.DB, vectorStoreID string, d FileCountsDelta) error {
+	result := tx.Model(&Collection{}).
+		Where("vector_store_id = ?", vectorStoreID).
+		Updates(map[string]interface{}{
+			"file_counts_cancelled":   gorm.Expr("file_counts_cancelled + ?", d.Cancelled),
+			"file_counts_completed":   gorm.Expr("file_counts_completed + ?", d.Completed),
+			"file_counts_failed":      gorm.Expr("file_counts_failed + ?", d.Failed),
+			"file_counts_in_progress": gorm.Expr("file_counts_in_progress + ?", d.InProgress),
+			"file_counts_total":       gorm.Expr("file_counts_total + ?", d.Total),
+			"version":                 gorm.Expr("version + 1"),
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateCollectionIndex updates the index of the collection. The index is updated regardless of the version
+// as the index is not changed by other updates, and the version is incremented so that concurrent updates with
+// the old index fail.
+func (s *S) UpdateCollectionIndex(nc *Collection) error {
+	result := s.db.Model(&Collection{}).
+		Where("id = ?", nc.ID).
+		Updates(map[string]interface{}{
+			"index_type":            nc.IndexType,
+			"index_n_list":          nc.IndexNList,
+			"index_n_probe":         nc.IndexNProbe,
+			"index_m":               nc.IndexM,
+			"index_n_bits":          nc.IndexNBits,
+			"index_ef_construction": nc.IndexEFConstruction,
+			"index_ef":              nc.IndexEF,
+			"index_search_list":     nc.IndexSearchList,
+			"version":               gorm.Expr("version + 1"),
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 // DeleteCollection deletes the collection.
 func (s *S) DeleteCollection(projectID string, vectorStoreID string) error {
 	return DeleteCollectionInTransaction(s.db, projectID, vectorStoreID)
@@ -48,6 +48,15 @@ func TestCreateAndGetCollection(t *testing.T) {
 	_, err = st.GetCollectionByVectorStoreID("different", vectorStoreID)
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	// Internal servers can get the collection without the project.
+	got, err = st.GetCollectionByVectorStoreIDInternal(vectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, collectionID, got.CollectionID)
+
+	_, err = st.GetCollectionByVectorStoreIDInternal("different")
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
 }
 
 func TestCreateAndListCollections(t *testing.T) {
@@ -201,6 +210,89 @@ func TestUpdateCollection(t *testing.T) {
 	assert.Equal(t, nc.FileCountsCompleted, got.FileCountsCompleted)
 }
 
+func TestUpdateCollectionIndex(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const project = "project0"
+
+	c := Collection{
+		VectorStoreID: "vs0",
+		CollectionID:  1,
+		Name:          "collection0",
+		ProjectID:     project,
+		IndexType:     "ivf_flat",
+		IndexNList:    128,
+		IndexNProbe:   16,
+	}
+	err := st.CreateCollection(&c)
+	assert.NoError(t, err)
+
+	nc := c
+	nc.IndexType = "hnsw"
+	nc.IndexNList = 0
+	nc.IndexNProbe = 0
+	nc.IndexM = 16
+	nc.IndexEFConstruction = 200
+	nc.IndexEF = 64
+	err = st.UpdateCollectionIndex(&nc)
+	assert.NoError(t, err)
+
+	got, err := st.GetCollectionByVectorStoreID(project, c.VectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, "hnsw", got.IndexType)
+	assert.Zero(t, got.IndexNList)
+	assert.Zero(t, got.IndexNProbe)
+	assert.Equal(t, 16, got.IndexM)
+	assert.Equal(t, 200, got.IndexEFConstruction)
+	assert.Equal(t, 64, got.IndexEF)
+
+	// An update with the old version fails.
+	c.FileCountsCompleted = 1
+	err = st.UpdateCollection(&c)
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+
+	err = st.UpdateCollectionIndex(&Collection{Model: gorm.Model{ID: 100}})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestAddFileCounts(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const project = "project0"
+
+	c := Collection{
+		VectorStoreID:        "vs0",
+		CollectionID:         1,
+		Name:                 "collection0",
+		ProjectID:            project,
+		FileCountsInProgress: 1,
+		FileCountsCompleted:  2,
+		FileCountsTotal:      3,
+	}
+	err := st.CreateCollection(&c)
+	assert.NoError(t, err)
+
+	err = AddFileCountsInTransaction(st.db, c.VectorStoreID, FileCountsDelta{InProgress: 1, Total: 1})
+	assert.NoError(t, err)
+	err = AddFileCountsInTransaction(st.db, c.VectorStoreID, FileCountsDelta{Completed: -1, Total: -1})
+	assert.NoError(t, err)
+
+	got, err := st.GetCollectionByVectorStoreID(project, c.VectorStoreID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), got.FileCountsInProgress)
+	assert.Equal(t, int64(1), got.FileCountsCompleted)
+	assert.Equal(t, int64(3), got.FileCountsTotal)
+
+	// An update with the old version fails.
+	err = st.UpdateCollection(&c)
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+
+	err = AddFileCountsInTransaction(st.db, "vs1", FileCountsDelta{Total: 1})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
 func TestDeleteCollection(t *testing.T) {
 	st, teardown := NewTest(t)
 	defer teardown()
@@ -5,4 +5,6 @@ import "fmt"
 var (
 	// ErrConcurrentUpdate is returned when there is a concurrent update.
 	ErrConcurrentUpdate = fmt.Errorf("store: concurrent update")
+	// ErrJobNotOwned is returned when a worker updates a job whose lease it no longer holds.
+	ErrJobNotOwned = fmt.Errorf("store: job not owned")
 )
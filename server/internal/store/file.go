@@ -1,6 +1,7 @@
 package store
 
 import (
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
@@ -31,11 +32,19 @@ const (
 	LastErrorCodeServerError LastErrorCode = "server_error"
 	// LastErrorCodeRateLimitExceeded represents a rate limit exceeded error.
 	LastErrorCodeRateLimitExceeded LastErrorCode = "rate_limit_exceeded"
+	// LastErrorCodeInvalidFile represents an invalid file error.
+	LastErrorCodeInvalidFile LastErrorCode = "invalid_file"
+	// LastErrorCodeUnsupportedFile represents an unsupported file error.
+	LastErrorCodeUnsupportedFile LastErrorCode = "unsupported_file"
 
 	// ChunkingStrategyTypeAuto represents the auto chunking strategy.
 	ChunkingStrategyTypeAuto ChunkingStrategyType = "auto"
 	// ChunkingStrategyTypeStatic represents the static chunking strategy.
 	ChunkingStrategyTypeStatic ChunkingStrategyType = "static"
+	// ChunkingStrategyTypeSemantic represents the semantic chunking strategy.
+	ChunkingStrategyTypeSemantic ChunkingStrategyType = "semantic"
+	// ChunkingStrategyTypeParentChild represents the parent-child chunking strategy.
+	ChunkingStrategyTypeParentChild ChunkingStrategyType = "parent_child"
 )
 
 // File represents a file.
@@ -50,7 +59,6 @@ type File struct {
 	// UsageBytes is the total vector store usage in bytes. Note that this may be different from the original file size.
 	UsageBytes int64
 
-	// TODO(guangrui): handle status update.
 	Status FileStatus
 
 	LastErrorCode    LastErrorCode
@@ -59,13 +67,28 @@ type File struct {
 	ChunkingStrategyType ChunkingStrategyType
 	MaxChunkSizeTokens   int64
 	ChunkOverlapTokens   int64
+	// BreakpointPercentile is the breakpoint percentile of the semantic chunking strategy.
+	BreakpointPercentile int32
+	// MaxParentChunkSizeTokens is the maximum size of parent chunks of the parent-child chunking strategy.
+	// MaxChunkSizeTokens is the maximum size of child chunks.
+	MaxParentChunkSizeTokens int64
+	// Splitter is the splitter that was used to split the file. It is set after the file has been processed.
+	Splitter string
+
+	// Attributes are the attributes of the file encoded in JSON. The values are strings, numbers or booleans.
+	Attributes []byte
 
 	Version int
 }
 
 // CreateFile creates a new file.
 func (s *S) CreateFile(f *File) error {
-	if err := s.db.Create(f).Error; err != nil {
+	return CreateFileInTransaction(s.db, f)
+}
+
+// CreateFileInTransaction creates a new file.
+func CreateFileInTransaction(tx *gorm.DB, f *File) error {
+	if err := tx.Create(f).Error; err != nil {
 		return err
 	}
 	return nil
@@ -131,9 +154,56 @@ func (s *S) ListFilesWithPagination(
 	return fs, hasMore, nil
 }
 
+// UpdateFileInTransaction updates the status and the last error of the file.
+func UpdateFileInTransaction(tx *gorm.DB, f *File) error {
+	result := tx.Model(&File{}).
+		Where("id = ?", f.ID).
+		Where("version = ?", f.Version).
+		Updates(map[string]interface{}{
+			"status":                f.Status,
+			"usage_bytes":           f.UsageBytes,
+			"last_error_code":       f.LastErrorCode,
+			"last_error_message":    f.LastErrorMessage,
+			"max_chunk_size_tokens": f.MaxChunkSizeTokens,
+			"chunk_overlap_tokens":  f.ChunkOverlapTokens,
+			"splitter":              f.Splitter,
+			"version":               f.Version + 1,
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("update file: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
+// UpdateFileAttributes updates the attributes of the file.
+func (s *S) UpdateFileAttributes(f *File) error {
+	result := s.db.Model(&File{}).
+		Where("id = ?", f.ID).
+		Where("version = ?", f.Version).
+		Updates(map[string]interface{}{
+			"attributes": f.Attributes,
+			"version":    f.Version + 1,
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("update file attributes: %w", ErrConcurrentUpdate)
+	}
+	return nil
+}
+
 // DeleteFile deletes the file.
 func (s *S) DeleteFile(vectorStoreID, fileID string) error {
-	result := s.db.Unscoped().
+	return DeleteFileInTransaction(s.db, vectorStoreID, fileID)
+}
+
+// DeleteFileInTransaction deletes the file.
+func DeleteFileInTransaction(tx *gorm.DB, vectorStoreID, fileID string) error {
+	result := tx.Unscoped().
 		Where("file_id = ?", fileID).
 		Where("vector_store_id = ?", vectorStoreID).
 		Delete(&File{})
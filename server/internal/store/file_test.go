@@ -127,6 +127,39 @@ func TestListFilesWithPagination(t *testing.T) {
 	}
 }
 
+func TestUpdateFileAttributes(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const (
+		fileID        = "file0"
+		vectorStoreID = "vs0"
+	)
+
+	err := st.CreateFile(&File{
+		FileID:        fileID,
+		VectorStoreID: vectorStoreID,
+		Status:        FileStatusCompleted,
+		Attributes:    []byte(`{"department":"sales"}`),
+	})
+	assert.NoError(t, err)
+
+	f, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	f.Attributes = []byte(`{"department":"support"}`)
+	err = st.UpdateFileAttributes(f)
+	assert.NoError(t, err)
+
+	got, err := st.GetFileByFileID(vectorStoreID, fileID)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"department":"support"}`, string(got.Attributes))
+	assert.Equal(t, f.Version+1, got.Version)
+
+	// The stale version is rejected.
+	err = st.UpdateFileAttributes(f)
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+}
+
 func TestDeleteFile(t *testing.T) {
 	st, teardown := NewTest(t)
 	defer teardown()
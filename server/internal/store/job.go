@@ -0,0 +1,210 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// JobStatus represents the status of a job.
+type JobStatus string
+
+const (
+	// JobStatusQueued represents the queued status.
+	JobStatusQueued JobStatus = "queued"
+	// JobStatusRunning represents the running status.
+	JobStatusRunning JobStatus = "running"
+)
+
+// Job represents a job that ingests a file into a vector store.
+type Job struct {
+	gorm.Model
+
+	ProjectID     string
+	VectorStoreID string `gorm:"index:idx_job_vector_store_id_file_id"`
+	FileID        string `gorm:"index:idx_job_vector_store_id_file_id"`
+
+	// FileName is the original name of the file. The extension is used to pick a document loader.
+	FileName string
+	// FilePath is the path of the file in the object store.
+	FilePath string
+
+	Status JobStatus `gorm:"index"`
+
+	// Owner identifies the worker process that runs the job.
+	Owner string
+	// LeaseExpiresAt is the time in Unix seconds when the lease of the owner expires. The owner renews the
+	// lease while it runs the job. A running job whose lease has expired is claimed again.
+	LeaseExpiresAt int64
+	// Attempts is the number of times the job has been claimed.
+	Attempts int
+
+	Version int
+}
+
+// CreateJob creates a new job.
+func (s *S) CreateJob(j *Job) error {
+	return CreateJobInTransaction(s.db, j)
+}
+
+// CreateJobInTransaction creates a new job.
+func CreateJobInTransaction(tx *gorm.DB, j *Job) error {
+	if err := tx.Create(j).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetJobByFileID gets a job.
+func (s *S) GetJobByFileID(vectorStoreID, fileID string) (*Job, error) {
+	var j Job
+	if err := s.db.Where("vector_store_id = ?", vectorStoreID).
+		Where("file_id = ?", fileID).
+		Take(&j).Error; err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
+
+// ClaimJob finds the oldest job that is queued or whose lease has expired, and changes its status to running
+// with a lease for the owner. gorm.ErrRecordNotFound is returned if there is no such job. ErrConcurrentUpdate
+// is returned if the job was claimed by someone else at the same time.
+func (s *S) ClaimJob(owner string, now time.Time, leaseDuration time.Duration) (*Job, error) {
+	var j Job
+	if err := s.db.Where("status = ? OR (status = ? AND lease_expires_at < ?)", JobStatusQueued, JobStatusRunning, now.Unix()).
+		Order("id").
+		Take(&j).Error; err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(leaseDuration).Unix()
+	result := s.db.Model(&Job{}).
+		Where("id = ?", j.ID).
+		Where("version = ?", j.Version).
+		Updates(map[string]interface{}{
+			"status":           JobStatusRunning,
+			"owner":            owner,
+			"lease_expires_at": expiresAt,
+			"attempts":         j.Attempts + 1,
+			"version":          j.Version + 1,
+		})
+	if err := result.Error; err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("claim job: %w", ErrConcurrentUpdate)
+	}
+	j.Status = JobStatusRunning
+	j.Owner = owner
+	j.LeaseExpiresAt = expiresAt
+	j.Attempts++
+	j.Version++
+	return &j, nil
+}
+
+// RenewJobLease extends the lease of a job that the owner runs. gorm.ErrRecordNotFound is returned if the
+// job no longer exists, and ErrJobNotOwned is returned if the owner no longer holds the lease.
+func (s *S) RenewJobLease(id uint, owner string, expiresAt time.Time) error {
+	result := s.db.Model(&Job{}).
+		Where("id = ?", id).
+		Where("owner = ?", owner).
+		Where("status = ?", JobStatusRunning).
+		Updates(map[string]interface{}{
+			"lease_expires_at": expiresAt.Unix(),
+			"version":          gorm.Expr("version + 1"),
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return notOwnedJobError(s.db, id)
+	}
+	return nil
+}
+
+// ReleaseJob puts a job that the owner runs back in the queue. gorm.ErrRecordNotFound is returned if the job
+// no longer exists, and ErrJobNotOwned is returned if the owner no longer holds the lease.
+func (s *S) ReleaseJob(id uint, owner string) error {
+	result := s.db.Model(&Job{}).
+		Where("id = ?", id).
+		Where("owner = ?", owner).
+		Where("status = ?", JobStatusRunning).
+		Updates(map[string]interface{}{
+			"status":           JobStatusQueued,
+			"owner":            "",
+			"lease_expires_at": 0,
+			"version":          gorm.Expr("version + 1"),
+		})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return notOwnedJobError(s.db, id)
+	}
+	return nil
+}
+
+// notOwnedJobError returns the error for a job that was not updated as it is not owned by the caller.
+func notOwnedJobError(tx *gorm.DB, id uint) error {
+	if err := tx.Unscoped().Where("id = ?", id).Take(&Job{}).Error; err != nil {
+		return err
+	}
+	return fmt.Errorf("job %d: %w", id, ErrJobNotOwned)
+}
+
+// DeleteJob deletes the job.
+func (s *S) DeleteJob(id uint) error {
+	return DeleteJobInTransaction(s.db, id)
+}
+
+// DeleteJobInTransaction deletes the job.
+func DeleteJobInTransaction(tx *gorm.DB, id uint) error {
+	result := tx.Unscoped().
+		Where("id = ?", id).
+		Delete(&Job{})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteOwnedJobInTransaction deletes a job that the owner runs. gorm.ErrRecordNotFound is returned if the
+// job no longer exists, and ErrJobNotOwned is returned if the owner no longer holds the lease.
+func DeleteOwnedJobInTransaction(tx *gorm.DB, id uint, owner string) error {
+	result := tx.Unscoped().
+		Where("id = ?", id).
+		Where("owner = ?", owner).
+		Delete(&Job{})
+	if err := result.Error; err != nil {
+		return err
+	}
+	if result.RowsAffected == 0 {
+		return notOwnedJobError(tx, id)
+	}
+	return nil
+}
+
+// DeleteJobsByFileIDInTransaction deletes all jobs of the file.
+func DeleteJobsByFileIDInTransaction(tx *gorm.DB, vectorStoreID, fileID string) error {
+	if err := tx.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Where("file_id = ?", fileID).
+		Delete(&Job{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteAllJobsByVectorStoreIDInTransaction deletes all jobs of the collection.
+func DeleteAllJobsByVectorStoreIDInTransaction(tx *gorm.DB, vectorStoreID string) error {
+	if err := tx.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Delete(&Job{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
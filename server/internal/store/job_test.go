@@ -0,0 +1,109 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestClaimJob(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	now := time.Now()
+	_, err := st.ClaimJob("w0", now, time.Minute)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	for _, fileID := range []string{"file0", "file1"} {
+		err := st.CreateJob(&Job{
+			VectorStoreID: "vs0",
+			FileID:        fileID,
+			Status:        JobStatusQueued,
+		})
+		assert.NoError(t, err)
+	}
+
+	got, err := st.ClaimJob("w0", now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "file0", got.FileID)
+	assert.Equal(t, JobStatusRunning, got.Status)
+	assert.Equal(t, "w0", got.Owner)
+	assert.Equal(t, now.Add(time.Minute).Unix(), got.LeaseExpiresAt)
+	assert.Equal(t, 1, got.Attempts)
+	job0 := got
+
+	got, err = st.ClaimJob("w1", now, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "file1", got.FileID)
+	job1 := got
+
+	// Jobs with live leases are not claimed.
+	_, err = st.ClaimJob("w1", now, time.Minute)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	// Only the owner renews the lease.
+	err = st.RenewJobLease(job0.ID, "w0", now.Add(time.Hour))
+	assert.NoError(t, err)
+	err = st.RenewJobLease(job0.ID, "w1", now.Add(time.Hour))
+	assert.True(t, errors.Is(err, ErrJobNotOwned))
+
+	// The job of w1 is taken over after its lease expires.
+	later := now.Add(2 * time.Minute)
+	got, err = st.ClaimJob("w0", later, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "file1", got.FileID)
+	assert.Equal(t, "w0", got.Owner)
+	assert.Equal(t, 2, got.Attempts)
+
+	err = DeleteOwnedJobInTransaction(st.db, job1.ID, "w1")
+	assert.True(t, errors.Is(err, ErrJobNotOwned))
+
+	err = st.ReleaseJob(job1.ID, "w0")
+	assert.NoError(t, err)
+	got, err = st.GetJobByFileID("vs0", "file1")
+	assert.NoError(t, err)
+	assert.Equal(t, JobStatusQueued, got.Status)
+	assert.Empty(t, got.Owner)
+
+	err = DeleteOwnedJobInTransaction(st.db, job0.ID, "w0")
+	assert.NoError(t, err)
+	_, err = st.GetJobByFileID("vs0", "file0")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	err = st.RenewJobLease(job0.ID, "w0", now.Add(time.Hour))
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestDeleteJobs(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	jobs := []*Job{
+		{VectorStoreID: "vs0", FileID: "file0"},
+		{VectorStoreID: "vs0", FileID: "file1"},
+		{VectorStoreID: "vs1", FileID: "file0"},
+	}
+	for _, j := range jobs {
+		j.Status = JobStatusQueued
+		err := st.CreateJob(j)
+		assert.NoError(t, err)
+	}
+
+	err := DeleteJobsByFileIDInTransaction(st.db, "vs0", "file0")
+	assert.NoError(t, err)
+	_, err = st.GetJobByFileID("vs0", "file0")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	_, err = st.GetJobByFileID("vs1", "file0")
+	assert.NoError(t, err)
+
+	err = DeleteAllJobsByVectorStoreIDInTransaction(st.db, "vs0")
+	assert.NoError(t, err)
+	_, err = st.GetJobByFileID("vs0", "file1")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	_, err = st.GetJobByFileID("vs1", "file0")
+	assert.NoError(t, err)
+}
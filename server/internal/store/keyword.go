@@ -0,0 +1,223 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// keywordBatchSize is the number of terms that are read or written by a single statement.
+const keywordBatchSize = 500
+
+// KeywordTerm represents a term that appears in the chunks of a collection of the vector database. The ID of
+// the term is the dimension of the sparse vectors that are used by keyword search.
+type KeywordTerm struct {
+	gorm.Model
+
+	CollectionName string `gorm:"uniqueIndex:idx_keyword_term_collection_name_term"`
+	Term           string `gorm:"uniqueIndex:idx_keyword_term_collection_name_term"`
+
+	// DocumentFrequency is the number of chunks that contain the term.
+	DocumentFrequency int64
+}
+
+// KeywordCollection represents the statistics of the chunks of a collection of the vector database.
+type KeywordCollection struct {
+	gorm.Model
+
+	CollectionName string `gorm:"uniqueIndex"`
+
+	// NumDocuments is the number of chunks.
+	NumDocuments int64
+	// NumTerms is the total number of terms in the chunks.
+	NumTerms int64
+}
+
+// KeywordFile represents what the chunks of a file add to the statistics of a collection. It is recorded so
+// that the statistics are updated once for each file and are restored when the file is deleted.
+type KeywordFile struct {
+	gorm.Model
+
+	CollectionName string `gorm:"uniqueIndex:idx_keyword_file_collection_name_file_id"`
+	FileID         string `gorm:"uniqueIndex:idx_keyword_file_collection_name_file_id"`
+
+	NumDocuments int64
+	NumTerms     int64
+	// DocumentFrequencies is the JSON-encoded map from the IDs of the terms to the number of chunks of the file
+	// that contain the terms.
+	DocumentFrequencies []byte
+}
+
+// GetOrCreateKeywordTerms returns the terms of the collection. The terms that do not exist are created with
+// no document frequency.
+func (s *S) GetOrCreateKeywordTerms(collectionName string, terms []string) ([]*KeywordTerm, error) {
+	terms = sortedUnique(terms)
+	for start := 0; start < len(terms); start += keywordBatchSize {
+		var kts []*KeywordTerm
+		for _, t := range terms[start:min(start+keywordBatchSize, len(terms))] {
+			kts = append(kts, &KeywordTerm{CollectionName: collectionName, Term: t})
+		}
+		if err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&kts).Error; err != nil {
+			return nil, err
+		}
+	}
+	return s.ListKeywordTerms(collectionName, terms)
+}
+
+// ListKeywordTerms lists the terms of the collection. Terms that do not exist are not returned.
+func (s *S) ListKeywordTerms(collectionName string, terms []string) ([]*KeywordTerm, error) {
+	terms = sortedUnique(terms)
+	var kts []*KeywordTerm
+	for start := 0; start < len(terms); start += keywordBatchSize {
+		var batch []*KeywordTerm
+		if err := s.db.Where("collection_name = ? AND term IN ?", collectionName, terms[start:min(start+keywordBatchSize, len(terms))]).
+			Find(&batch).Error; err != nil {
+			return nil, err
+		}
+		kts = append(kts, batch...)
+	}
+	return kts, nil
+}
+
+// GetKeywordCollection gets the statistics of the collection.
+func (s *S) GetKeywordCollection(collectionName string) (*KeywordCollection, error) {
+	var kc KeywordCollection
+	if err := s.db.Where("collection_name = ?", collectionName).Take(&kc).Error; err != nil {
+		return nil, err
+	}
+	return &kc, nil
+}
+
+// AddKeywordFile adds the chunks of the file to the statistics of its collection. documentFrequencies maps
+// the IDs of the terms to the number of chunks of the file that contain the terms. Nothing is done if the file
+// has already been added.
+func (s *S) AddKeywordFile(f *KeywordFile, documentFrequencies map[uint]int64) error {
+	b, err := json.Marshal(documentFrequencies)
+	if err != nil {
+		return fmt.Errorf("marshal document frequencies: %s", err)
+	}
+	f.DocumentFrequencies = b
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		// The statistics of the collection are locked first so that the files of a collection are added and
+		// deleted one by one.
+		if err := addKeywordCollectionInTransaction(tx, f.CollectionName, f.NumDocuments, f.NumTerms); err != nil {
+			return err
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(f)
+		if err := result.Error; err != nil {
+			return err
+		}
+		if result.RowsAffected == 0 {
+			// The file has already been added. Roll back the update of the statistics of the collection.
+			return errKeywordFileExists
+		}
+		return addDocumentFrequenciesInTransaction(tx, documentFrequencies, 1)
+	})
+	if errors.Is(err, errKeywordFileExists) {
+		return nil
+	}
+	return err
+}
+
+// DeleteKeywordFile subtracts the chunks of the file from the statistics of its collection. Nothing is done if
+// the file has not been added.
+func (s *S) DeleteKeywordFile(collectionName, fileID string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var f KeywordFile
+		if err := tx.Where("collection_name = ? AND file_id = ?", collectionName, fileID).Take(&f).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		var dfs map[uint]int64
+		if err := json.Unmarshal(f.DocumentFrequencies, &dfs); err != nil {
+			return fmt.Errorf("unmarshal document frequencies: %s", err)
+		}
+		if err := addKeywordCollectionInTransaction(tx, collectionName, -f.NumDocuments, -f.NumTerms); err != nil {
+			return err
+		}
+		if err := addDocumentFrequenciesInTransaction(tx, dfs, -1); err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&f).Error
+	})
+}
+
+// RenameKeywordCollection changes the collection name of the statistics.
+func (s *S) RenameKeywordCollection(oldName, newName string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range []any{&KeywordTerm{}, &KeywordCollection{}, &KeywordFile{}} {
+			if err := tx.Model(m).Where("collection_name = ?", oldName).Update("collection_name", newName).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteKeywordCollection deletes the statistics of the collection.
+func (s *S) DeleteKeywordCollection(collectionName string) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		for _, m := range []any{&KeywordTerm{}, &KeywordCollection{}, &KeywordFile{}} {
+			if err := tx.Unscoped().Where("collection_name = ?", collectionName).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// errKeywordFileExists rolls back the transaction of AddKeywordFile when the file has already been added.
+var errKeywordFileExists = errors.New("keyword file exists")
+
+// addKeywordCollectionInTransaction adds the numbers of chunks and terms to the statistics of the collection.
+func addKeywordCollectionInTransaction(tx *gorm.DB, collectionName string, numDocuments, numTerms int64) error {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&KeywordCollection{CollectionName: collectionName}).Error; err != nil {
+		return err
+	}
+	return tx.Model(&KeywordCollection{}).
+		Where("collection_name = ?", collectionName).
+		Updates(map[string]interface{}{
+			"num_documents": gorm.Expr("num_documents + ?", numDocuments),
+			"num_terms":     gorm.Expr("num_terms + ?", numTerms),
+		}).Error
+}
+
+// addDocumentFrequenciesInTransaction adds the document frequencies multiplied by sign to the terms. Terms with
+// the same document frequency are updated by a single statement.
+func addDocumentFrequenciesInTransaction(tx *gorm.DB, documentFrequencies map[uint]int64, sign int64) error {
+	idsByDF := map[int64][]uint{}
+	for id, df := range documentFrequencies {
+		idsByDF[df] = append(idsByDF[df], id)
+	}
+	for df, ids := range idsByDF {
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for start := 0; start < len(ids); start += keywordBatchSize {
+			if err := tx.Model(&KeywordTerm{}).
+				Where("id IN ?", ids[start:min(start+keywordBatchSize, len(ids))]).
+				Update("document_frequency", gorm.Expr("document_frequency + ?", sign*df)).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sortedUnique(ss []string) []string {
+	seen := map[string]bool{}
+	var us []string
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			us = append(us, s)
+		}
+	}
+	sort.Strings(us)
+	return us
+}
@@ -0,0 +1,84 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestKeywordStatistics(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const collectionName = "c0"
+
+	kts, err := st.GetOrCreateKeywordTerms(collectionName, []string{"error", "e42", "error"})
+	assert.NoError(t, err)
+	assert.Len(t, kts, 2)
+	ids := map[string]uint{}
+	for _, kt := range kts {
+		ids[kt.Term] = kt.ID
+		assert.Equal(t, int64(0), kt.DocumentFrequency)
+	}
+
+	// Existing terms keep their IDs.
+	kts, err = st.GetOrCreateKeywordTerms(collectionName, []string{"error", "warn"})
+	assert.NoError(t, err)
+	assert.Len(t, kts, 2)
+	for _, kt := range kts {
+		if kt.Term == "error" {
+			assert.Equal(t, ids["error"], kt.ID)
+		}
+	}
+	// The same term in another collection has another ID.
+	kts, err = st.GetOrCreateKeywordTerms("c1", []string{"error"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, ids["error"], kts[0].ID)
+
+	dfs := map[uint]int64{ids["error"]: 2, ids["e42"]: 1}
+	for i := 0; i < 2; i++ {
+		// The file is added only once.
+		err = st.AddKeywordFile(&KeywordFile{CollectionName: collectionName, FileID: "f0", NumDocuments: 2, NumTerms: 5}, dfs)
+		assert.NoError(t, err)
+	}
+	err = st.AddKeywordFile(&KeywordFile{CollectionName: collectionName, FileID: "f1", NumDocuments: 1, NumTerms: 3}, map[uint]int64{ids["error"]: 1})
+	assert.NoError(t, err)
+
+	assertStats := func(wantDocs, wantTerms int64, wantDFs map[string]int64) {
+		kc, err := st.GetKeywordCollection(collectionName)
+		assert.NoError(t, err)
+		assert.Equal(t, wantDocs, kc.NumDocuments)
+		assert.Equal(t, wantTerms, kc.NumTerms)
+		kts, err := st.ListKeywordTerms(collectionName, []string{"error", "e42", "unknown"})
+		assert.NoError(t, err)
+		got := map[string]int64{}
+		for _, kt := range kts {
+			got[kt.Term] = kt.DocumentFrequency
+		}
+		assert.Equal(t, wantDFs, got)
+	}
+	assertStats(3, 8, map[string]int64{"error": 3, "e42": 1})
+
+	for i := 0; i < 2; i++ {
+		// Deleting a file that has already been deleted does nothing.
+		err = st.DeleteKeywordFile(collectionName, "f0")
+		assert.NoError(t, err)
+	}
+	assertStats(1, 3, map[string]int64{"error": 1, "e42": 0})
+
+	err = st.RenameKeywordCollection(collectionName, "c2")
+	assert.NoError(t, err)
+	kc, err := st.GetKeywordCollection("c2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), kc.NumDocuments)
+
+	err = st.DeleteKeywordCollection("c2")
+	assert.NoError(t, err)
+	_, err = st.GetKeywordCollection("c2")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	kts, err = st.ListKeywordTerms("c2", []string{"error"})
+	assert.NoError(t, err)
+	assert.Empty(t, kts)
+}
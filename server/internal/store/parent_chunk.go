@@ -0,0 +1,64 @@
+package store
+
+import (
+	"gorm.io/gorm"
+)
+
+// ParentChunk represents a parent chunk of the parent-child chunking strategy. Child chunks are stored
+// in the vector database and reference their parent chunks by ChunkID. Parent chunks are stored here as
+// they can be longer than the texts that the vector database can store.
+type ParentChunk struct {
+	gorm.Model
+
+	VectorStoreID string `gorm:"uniqueIndex:idx_parent_chunk_vector_store_id_chunk_id;index:idx_parent_chunk_vector_store_id_file_id"`
+	FileID        string `gorm:"index:idx_parent_chunk_vector_store_id_file_id"`
+
+	// ChunkID is the ID of the parent chunk that is referenced by the child chunks.
+	ChunkID string `gorm:"uniqueIndex:idx_parent_chunk_vector_store_id_chunk_id"`
+
+	Text string
+}
+
+// CreateParentChunks creates parent chunks.
+func (s *S) CreateParentChunks(cs []*ParentChunk) error {
+	if len(cs) == 0 {
+		return nil
+	}
+	if err := s.db.CreateInBatches(cs, 100).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListParentChunksByChunkIDs lists the parent chunks that have the given chunk IDs.
+func (s *S) ListParentChunksByChunkIDs(vectorStoreID string, chunkIDs []string) ([]*ParentChunk, error) {
+	var cs []*ParentChunk
+	if len(chunkIDs) == 0 {
+		return cs, nil
+	}
+	if err := s.db.Where("vector_store_id = ? AND chunk_id IN ?", vectorStoreID, chunkIDs).Find(&cs).Error; err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// DeleteParentChunksByFileID deletes all parent chunks of the file.
+func (s *S) DeleteParentChunksByFileID(vectorStoreID, fileID string) error {
+	if err := s.db.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Where("file_id = ?", fileID).
+		Delete(&ParentChunk{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteAllParentChunksByVectorStoreIDInTransaction deletes all parent chunks of the collection.
+func DeleteAllParentChunksByVectorStoreIDInTransaction(tx *gorm.DB, vectorStoreID string) error {
+	if err := tx.Unscoped().
+		Where("vector_store_id = ?", vectorStoreID).
+		Delete(&ParentChunk{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateListDeleteParentChunks(t *testing.T) {
+	st, teardown := NewTest(t)
+	defer teardown()
+
+	const (
+		vectorStoreID = "vs1"
+	)
+
+	var cs []*ParentChunk
+	for _, fileID := range []string{"file0", "file1"} {
+		for i := 0; i < 3; i++ {
+			cs = append(cs, &ParentChunk{
+				VectorStoreID: vectorStoreID,
+				FileID:        fileID,
+				ChunkID:       fmt.Sprintf("%s-%d", fileID, i),
+				Text:          fmt.Sprintf("text %s %d", fileID, i),
+			})
+		}
+	}
+	cs = append(cs, &ParentChunk{
+		VectorStoreID: "different",
+		FileID:        "file0",
+		ChunkID:       "file0-0",
+		Text:          "different",
+	})
+	err := st.CreateParentChunks(cs)
+	assert.NoError(t, err)
+
+	// The same chunk ID cannot be used twice in a vector store.
+	err = st.CreateParentChunks([]*ParentChunk{{VectorStoreID: vectorStoreID, FileID: "file0", ChunkID: "file0-0"}})
+	assert.Error(t, err)
+
+	got, err := st.ListParentChunksByChunkIDs(vectorStoreID, []string{"file0-1", "file1-2", "unknown"})
+	assert.NoError(t, err)
+	var texts []string
+	for _, c := range got {
+		texts = append(texts, c.Text)
+	}
+	assert.ElementsMatch(t, []string{"text file0 1", "text file1 2"}, texts)
+
+	err = st.DeleteParentChunksByFileID(vectorStoreID, "file0")
+	assert.NoError(t, err)
+	got, err = st.ListParentChunksByChunkIDs(vectorStoreID, []string{"file0-0", "file1-0"})
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "file1-0", got[0].ChunkID)
+
+	err = DeleteAllParentChunksByVectorStoreIDInTransaction(st.db, vectorStoreID)
+	assert.NoError(t, err)
+	got, err = st.ListParentChunksByChunkIDs(vectorStoreID, []string{"file1-0"})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	// Parent chunks of other vector stores are kept.
+	got, err = st.ListParentChunksByChunkIDs("different", []string{"file0-0"})
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+}
@@ -0,0 +1,91 @@
+package vectordb
+
+import "fmt"
+
+// FilterType is the type of a filter.
+type FilterType string
+
+const (
+	// FilterTypeEq matches documents whose attribute is equal to the value.
+	FilterTypeEq FilterType = "eq"
+	// FilterTypeNe matches documents whose attribute is not equal to the value.
+	FilterTypeNe FilterType = "ne"
+	// FilterTypeGt matches documents whose attribute is greater than the value.
+	FilterTypeGt FilterType = "gt"
+	// FilterTypeGte matches documents whose attribute is greater than or equal to the value.
+	FilterTypeGte FilterType = "gte"
+	// FilterTypeLt matches documents whose attribute is less than the value.
+	FilterTypeLt FilterType = "lt"
+	// FilterTypeLte matches documents whose attribute is less than or equal to the value.
+	FilterTypeLte FilterType = "lte"
+	// FilterTypeIn matches documents whose attribute is one of the values.
+	FilterTypeIn FilterType = "in"
+	// FilterTypeAnd matches documents that match all the filters.
+	FilterTypeAnd FilterType = "and"
+	// FilterTypeOr matches documents that match any of the filters.
+	FilterTypeOr FilterType = "or"
+)
+
+// Filter is a filter on the attributes of documents. A comparison filter compares the attribute of Key
+// with Value, and a compound filter combines Filters.
+type Filter struct {
+	Type FilterType
+	Key  string
+	// Value is a string, a float64 or a bool. It is a slice of them for FilterTypeIn.
+	Value   any
+	Filters []*Filter
+}
+
+// Validate returns an error if the filter is malformed.
+func (f *Filter) Validate() error {
+	switch f.Type {
+	case FilterTypeAnd, FilterTypeOr:
+		if len(f.Filters) == 0 {
+			return fmt.Errorf("%s filter must have at least one filter", f.Type)
+		}
+		for _, sf := range f.Filters {
+			if err := sf.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FilterTypeIn:
+		if f.Key == "" {
+			return fmt.Errorf("in filter must have a key")
+		}
+		vs, ok := f.Value.([]any)
+		if !ok || len(vs) == 0 {
+			return fmt.Errorf("in filter must have a non-empty list of values")
+		}
+		for _, v := range vs {
+			if err := validateValue(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FilterTypeEq, FilterTypeNe:
+		if f.Key == "" {
+			return fmt.Errorf("%s filter must have a key", f.Type)
+		}
+		return validateValue(f.Value)
+	case FilterTypeGt, FilterTypeGte, FilterTypeLt, FilterTypeLte:
+		if f.Key == "" {
+			return fmt.Errorf("%s filter must have a key", f.Type)
+		}
+		if _, ok := f.Value.(float64); !ok {
+			return fmt.Errorf("%s filter must have a number value", f.Type)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported filter type %q", f.Type)
+	}
+}
+
+func validateValue(v any) error {
+	switch v.(type) {
+	case string, float64, bool:
+		return nil
+	default:
+		return fmt.Errorf("unsupported filter value %v", v)
+	}
+}
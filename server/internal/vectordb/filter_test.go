@@ -0,0 +1,86 @@
+package vectordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterValidate(t *testing.T) {
+	tcs := []struct {
+		name    string
+		filter  *Filter
+		wantErr bool
+	}{
+		{
+			name:   "eq string",
+			filter: &Filter{Type: FilterTypeEq, Key: "author", Value: "alice"},
+		},
+		{
+			name:   "ne bool",
+			filter: &Filter{Type: FilterTypeNe, Key: "draft", Value: true},
+		},
+		{
+			name:   "in",
+			filter: &Filter{Type: FilterTypeIn, Key: "lang", Value: []any{"en", 1.0}},
+		},
+		{
+			name: "compound",
+			filter: &Filter{
+				Type: FilterTypeOr,
+				Filters: []*Filter{
+					{Type: FilterTypeGte, Key: "year", Value: 2024.0},
+					{Type: FilterTypeEq, Key: "author", Value: "alice"},
+				},
+			},
+		},
+		{
+			name:    "unsupported type",
+			filter:  &Filter{Type: "like", Key: "author", Value: "a%"},
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			filter:  &Filter{Type: FilterTypeEq, Value: "alice"},
+			wantErr: true,
+		},
+		{
+			name:    "string range",
+			filter:  &Filter{Type: FilterTypeGt, Key: "author", Value: "alice"},
+			wantErr: true,
+		},
+		{
+			name:    "empty in",
+			filter:  &Filter{Type: FilterTypeIn, Key: "lang", Value: []any{}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported value",
+			filter:  &Filter{Type: FilterTypeEq, Key: "tags", Value: map[string]any{}},
+			wantErr: true,
+		},
+		{
+			name: "invalid nested filter",
+			filter: &Filter{
+				Type:    FilterTypeAnd,
+				Filters: []*Filter{{Type: FilterTypeLt, Key: "year"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "empty compound",
+			filter:  &Filter{Type: FilterTypeAnd},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
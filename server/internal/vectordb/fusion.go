@@ -0,0 +1,19 @@
+package vectordb
+
+// FusionType is the method that merges the results of the vector search and the keyword search.
+type FusionType string
+
+const (
+	// FusionTypeRRF merges the results by reciprocal rank fusion.
+	FusionTypeRRF FusionType = "rrf"
+	// FusionTypeWeighted merges the results by the weighted sum of their normalized scores.
+	FusionTypeWeighted FusionType = "weighted"
+)
+
+// Fusion configures how the results of the vector search and the keyword search are merged.
+type Fusion struct {
+	Type FusionType
+	// VectorWeight is the weight of the vector search between 0 and 1 for FusionTypeWeighted. The keyword
+	// search has the weight of 1 - VectorWeight.
+	VectorWeight float64
+}
@@ -0,0 +1,125 @@
+package vectordb
+
+import "fmt"
+
+// IndexType is the type of the index of the vectors in a collection.
+type IndexType string
+
+const (
+	// IndexTypeIVFFlat clusters the vectors and searches the closest clusters. Collections created by older
+	// versions use this index.
+	IndexTypeIVFFlat IndexType = "ivf_flat"
+	// IndexTypeFlat searches all the vectors. It is exact and suitable for small collections.
+	IndexTypeFlat IndexType = "flat"
+	// IndexTypeHNSW searches a graph of the vectors. It is fast and accurate, but uses more memory.
+	IndexTypeHNSW IndexType = "hnsw"
+	// IndexTypeIVFPQ clusters the vectors and compresses them by product quantization. It is suitable for
+	// large collections.
+	IndexTypeIVFPQ IndexType = "ivf_pq"
+	// IndexTypeDiskANN searches a graph of the vectors that is stored on disk. It is suitable for very
+	// large collections.
+	IndexTypeDiskANN IndexType = "diskann"
+)
+
+const (
+	defaultIvfNList           = 128
+	defaultIvfNProbe          = 16
+	defaultHNSWM              = 16
+	defaultHNSWEFConstruction = 200
+	defaultHNSWEF             = 64
+	defaultIvfPQM             = 8
+	defaultIvfPQNBits         = 8
+	defaultDiskANNSearchList  = 100
+)
+
+// Index configures how the vectors of a collection are indexed and searched. Only the parameters of the
+// index type are used.
+type Index struct {
+	MetricType MetricType
+	Type       IndexType
+
+	// NList is the number of clusters of IVF_FLAT and IVF_PQ.
+	NList int
+	// NProbe is the number of clusters that are searched by IVF_FLAT and IVF_PQ.
+	NProbe int
+	// M is the maximum number of edges of each node of HNSW, and the number of sub-vectors of IVF_PQ.
+	M int
+	// NBits is the number of bits that encode each sub-vector of IVF_PQ.
+	NBits int
+	// EFConstruction is the number of candidates that are considered when HNSW is built.
+	EFConstruction int
+	// EF is the number of candidates that are considered when HNSW is searched.
+	EF int
+	// SearchList is the number of candidates that are considered when DiskANN is searched.
+	SearchList int
+}
+
+// WithDefaults returns the index with the defaults of the index type for the parameters that are not set.
+// The parameters that the index type does not use are cleared.
+func (i Index) WithDefaults() Index {
+	d := Index{
+		MetricType: i.MetricType,
+		Type:       i.Type,
+	}
+	if d.MetricType == "" {
+		d.MetricType = MetricTypeL2
+	}
+	switch i.Type {
+	case IndexTypeIVFFlat, "":
+		d.Type = IndexTypeIVFFlat
+		d.NList = orDefault(i.NList, defaultIvfNList)
+		d.NProbe = orDefault(i.NProbe, defaultIvfNProbe)
+	case IndexTypeHNSW:
+		d.M = orDefault(i.M, defaultHNSWM)
+		d.EFConstruction = orDefault(i.EFConstruction, defaultHNSWEFConstruction)
+		d.EF = orDefault(i.EF, defaultHNSWEF)
+	case IndexTypeIVFPQ:
+		d.NList = orDefault(i.NList, defaultIvfNList)
+		d.NProbe = orDefault(i.NProbe, defaultIvfNProbe)
+		d.M = orDefault(i.M, defaultIvfPQM)
+		d.NBits = orDefault(i.NBits, defaultIvfPQNBits)
+	case IndexTypeDiskANN:
+		d.SearchList = orDefault(i.SearchList, defaultDiskANNSearchList)
+	}
+	return d
+}
+
+// Validate returns an error if the index is not valid for vectors of the dimensions. Vector databases can
+// further restrict the index types and the parameters.
+func (i Index) Validate(dimensions int) error {
+	switch i.MetricType {
+	case "", MetricTypeL2, MetricTypeIP, MetricTypeCosine:
+	default:
+		return fmt.Errorf("unsupported metric type %q", i.MetricType)
+	}
+	switch i.Type {
+	case "", IndexTypeIVFFlat, IndexTypeFlat, IndexTypeHNSW, IndexTypeIVFPQ, IndexTypeDiskANN:
+	default:
+		return fmt.Errorf("unsupported index type %q", i.Type)
+	}
+	for name, v := range map[string]int{
+		"nlist":           i.NList,
+		"nprobe":          i.NProbe,
+		"m":               i.M,
+		"nbits":           i.NBits,
+		"ef_construction": i.EFConstruction,
+		"ef":              i.EF,
+		"search_list":     i.SearchList,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	i = i.WithDefaults()
+	if i.Type == IndexTypeIVFPQ && dimensions%i.M != 0 {
+		return fmt.Errorf("m must divide the dimensions %d", dimensions)
+	}
+	return nil
+}
+
+func orDefault(v, d int) int {
+	if v == 0 {
+		return d
+	}
+	return v
+}
@@ -0,0 +1,102 @@
+package vectordb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexWithDefaults(t *testing.T) {
+	tcs := []struct {
+		name  string
+		index Index
+		want  Index
+	}{
+		{
+			name:  "empty",
+			index: Index{},
+			want:  Index{MetricType: MetricTypeL2, Type: IndexTypeIVFFlat, NList: 128, NProbe: 16},
+		},
+		{
+			name:  "ivf flat",
+			index: Index{MetricType: MetricTypeCosine, Type: IndexTypeIVFFlat, NList: 1024},
+			want:  Index{MetricType: MetricTypeCosine, Type: IndexTypeIVFFlat, NList: 1024, NProbe: 16},
+		},
+		{
+			name:  "flat",
+			index: Index{MetricType: MetricTypeIP, Type: IndexTypeFlat, NList: 1024, EF: 10},
+			want:  Index{MetricType: MetricTypeIP, Type: IndexTypeFlat},
+		},
+		{
+			name:  "hnsw",
+			index: Index{Type: IndexTypeHNSW, M: 32},
+			want:  Index{MetricType: MetricTypeL2, Type: IndexTypeHNSW, M: 32, EFConstruction: 200, EF: 64},
+		},
+		{
+			name:  "ivf pq",
+			index: Index{Type: IndexTypeIVFPQ, NBits: 4},
+			want:  Index{MetricType: MetricTypeL2, Type: IndexTypeIVFPQ, NList: 128, NProbe: 16, M: 8, NBits: 4},
+		},
+		{
+			name:  "diskann",
+			index: Index{Type: IndexTypeDiskANN},
+			want:  Index{MetricType: MetricTypeL2, Type: IndexTypeDiskANN, SearchList: 100},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.index.WithDefaults())
+		})
+	}
+}
+
+func TestIndexValidate(t *testing.T) {
+	tcs := []struct {
+		name    string
+		index   Index
+		wantErr bool
+	}{
+		{
+			name:  "default",
+			index: Index{},
+		},
+		{
+			name:  "hnsw",
+			index: Index{MetricType: MetricTypeCosine, Type: IndexTypeHNSW, M: 8, EFConstruction: 100, EF: 20},
+		},
+		{
+			name:  "diskann",
+			index: Index{Type: IndexTypeDiskANN, SearchList: 50},
+		},
+		{
+			name:    "unknown type",
+			index:   Index{Type: "scann"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown metric type",
+			index:   Index{MetricType: "hamming"},
+			wantErr: true,
+		},
+		{
+			name:    "negative nprobe",
+			index:   Index{Type: IndexTypeIVFFlat, NProbe: -1},
+			wantErr: true,
+		},
+		{
+			name:    "m of ivf pq not dividing dimensions",
+			index:   Index{Type: IndexTypeIVFPQ, M: 5},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.index.Validate(384)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package vectordb
+
+// MetricType is the metric that measures the similarity between vectors in a collection.
+type MetricType string
+
+const (
+	// MetricTypeL2 measures the Euclidean distance. Collections created by older versions use this metric.
+	MetricTypeL2 MetricType = "l2"
+	// MetricTypeIP measures the inner product. Vectors must be normalized so that the inner product is equal
+	// to the cosine similarity.
+	MetricTypeIP MetricType = "ip"
+	// MetricTypeCosine measures the cosine similarity.
+	MetricTypeCosine MetricType = "cosine"
+)
+
+// RequiresNormalizedVectors returns true if the vectors must be L2-normalized for the metric.
+func (m MetricType) RequiresNormalizedVectors() bool {
+	return m == MetricTypeIP
+}
@@ -0,0 +1,105 @@
+// Package vectordb defines the vector databases that store the chunks of vector stores and the types that
+// are shared by them.
+package vectordb
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrHybridSearchNotSupported is returned by HybridSearch when the vector database does not support keyword
+// search.
+var ErrHybridSearchNotSupported = errors.New("hybrid search is not supported by the vector database")
+
+// Client is a vector database. The chunks of each vector store are stored in a collection that is named
+// after the vector store.
+type Client interface {
+	// CreateVectorStore creates a collection and returns its ID. The vectors of the collection are indexed
+	// with the index.
+	CreateVectorStore(ctx context.Context, name string, dimensions int, index Index) (int64, error)
+	// RebuildIndex replaces the index of the vectors of a collection with a new index.
+	RebuildIndex(ctx context.Context, name string, index Index) error
+	// ValidateIndex returns an error if the index cannot be built for vectors of the dimensions.
+	ValidateIndex(index Index, dimensions int) error
+	// ListVectorStores returns the IDs of the collections.
+	ListVectorStores(ctx context.Context) ([]int64, error)
+	UpdateVectorStoreName(ctx context.Context, oldName, newName string) error
+	DeleteVectorStore(ctx context.Context, name string) error
+
+	// InsertDocuments inserts documents into a collection. parentIDs are the IDs of the parent chunks of the
+	// documents, and chunkIndexes are the positions of the documents in their files. attributes are the
+	// attributes of the file, and are stored with every document.
+	InsertDocuments(
+		ctx context.Context,
+		name string,
+		files, texts []string,
+		metadatas []map[string]any,
+		parentIDs []string,
+		chunkIndexes []int64,
+		attributes map[string]any,
+		vectors [][]float32,
+	) error
+	// DeleteDocuments deletes the documents of a file from a collection.
+	DeleteDocuments(ctx context.Context, collectionName, fileID string) error
+	// UpdateAttributes replaces the attributes of the documents of a file.
+	UpdateAttributes(ctx context.Context, collectionName, fileID string, attributes map[string]any) error
+
+	// Search returns the documents with the most similar vectors in the order of similarity.
+	Search(
+		ctx context.Context,
+		collectionName string,
+		index Index,
+		vectors []float32,
+		numDocuments int,
+		filter *Filter,
+		withVectors bool,
+	) ([]Document, error)
+	// HybridSearch merges the results of a vector search and a keyword search. ErrHybridSearchNotSupported
+	// is returned if the vector database does not support keyword search.
+	HybridSearch(
+		ctx context.Context,
+		collectionName string,
+		index Index,
+		vectors []float32,
+		query string,
+		numDocuments int,
+		filter *Filter,
+		fusion Fusion,
+		withVectors bool,
+	) ([]Document, error)
+	// ListDocumentsByChunkRanges returns the documents in the ranges of chunks in no particular order.
+	ListDocumentsByChunkRanges(ctx context.Context, collectionName string, ranges []ChunkRange) ([]Document, error)
+}
+
+// Document is a chunk that is stored in a vector database.
+type Document struct {
+	// ID is the primary key of the document.
+	ID     int64
+	FileID string
+	Text   string
+	// Metadata is nil if the collection does not have the metadata column.
+	Metadata map[string]any
+	// Distance is the distance between the document and the query vector. It is set only by Search.
+	Distance float32
+	// Score is the relevance of the document between 0 and 1. Higher is more relevant. It is the
+	// similarity to the query vector for Search, and the merged score of the vector search and the
+	// keyword search for HybridSearch.
+	Score float32
+	// ParentID is the ID of the parent chunk if the document is a child chunk.
+	ParentID string
+	// ChunkIndex is the position of the document in its file. It is -1 if the collection does not
+	// have the chunk index column.
+	ChunkIndex int64
+	// Attributes are the attributes of the file. They are nil if the collection does not have the
+	// attributes column.
+	Attributes map[string]any
+	// Vector is the embedding of the document. It is set only if the search requests vectors.
+	Vector []float32
+}
+
+// ChunkRange is a range of chunks in a file. Both Start and End are inclusive.
+type ChunkRange struct {
+	FileID string
+	Start  int64
+	End    int64
+}
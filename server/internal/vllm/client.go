@@ -27,16 +27,36 @@ type Client struct {
 
 // Embed creates embeddings.
 func (c *Client) Embed(ctx context.Context, modelName, prompt string) ([]float32, error) {
+	es, err := c.EmbedBatch(ctx, modelName, []string{prompt})
+	if err != nil {
+		return nil, err
+	}
+	return es[0], nil
+}
+
+// EmbedBatch creates embeddings for multiple prompts in a single request.
+func (c *Client) EmbedBatch(ctx context.Context, modelName string, prompts []string) ([][]float32, error) {
 	req := openai.EmbeddingRequest{
-		Input:          []string{prompt},
+		Input:          prompts,
 		Model:          openai.EmbeddingModel(modelName),
 		EncodingFormat: openai.EmbeddingEncodingFormatFloat,
 	}
 	resp, err := c.client.CreateEmbeddings(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("create embeddings: %s", err)
+		return nil, fmt.Errorf("create embeddings: %w", err)
+	}
+	if len(resp.Data) != len(prompts) {
+		return nil, fmt.Errorf("unexpected number of embeddings: got %d, want %d", len(resp.Data), len(prompts))
+	}
+	// The order of the returned embeddings is not guaranteed. Use the index to sort them.
+	es := make([][]float32, len(prompts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(prompts) {
+			return nil, fmt.Errorf("unexpected embedding index: %d", d.Index)
+		}
+		es[d.Index] = d.Embedding
 	}
-	return resp.Data[0].Embedding, nil
+	return es, nil
 }
 
 // PullModel pulls a model.
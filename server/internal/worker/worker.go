@@ -0,0 +1,411 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/common/pkg/id"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"gorm.io/gorm"
+)
+
+const (
+	maxUpdateRetries = 5
+
+	// jobLeaseDuration is how long a job stays owned by a worker that stopped renewing its lease. The
+	// lease is renewed three times in the duration while the job is processed.
+	jobLeaseDuration = time.Minute
+	// maxJobAttempts is the number of times a job is claimed before the file is marked as failed.
+	maxJobAttempts = 5
+)
+
+var (
+	errLeaseLost  = errors.New("job lease lost")
+	errJobDeleted = errors.New("job deleted")
+)
+
+type fileEmbedder interface {
+	AddFile(
+		ctx context.Context,
+		collectionName, modelName string,
+		metric vectordb.MetricType,
+		legacyEmbedding bool,
+		fileID, fileName, filePath string,
+		cs embedder.ChunkingStrategy,
+		attributes map[string]any,
+	) (*embedder.Chunking, error)
+	DeleteFile(ctx context.Context, collectionName, fileID string) error
+}
+
+// New creates a new worker.
+func New(
+	store *store.S,
+	e fileEmbedder,
+	numWorkers int,
+	pollingInterval time.Duration,
+	log logr.Logger,
+) (*W, error) {
+	// The owner identifies this process. The random suffix distinguishes restarts of a pod with the same name.
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("get hostname: %s", err)
+	}
+	owner, err := id.GenerateID(hostname+"-", 8)
+	if err != nil {
+		return nil, fmt.Errorf("generate owner: %s", err)
+	}
+	return &W{
+		store:           store,
+		embedder:        e,
+		owner:           owner,
+		numWorkers:      numWorkers,
+		pollingInterval: pollingInterval,
+		leaseDuration:   jobLeaseDuration,
+		log:             log.WithName("worker"),
+	}, nil
+}
+
+// W processes file ingestion jobs in the background.
+type W struct {
+	store    *store.S
+	embedder fileEmbedder
+
+	// owner identifies the worker process in the leases of the jobs it runs.
+	owner string
+
+	numWorkers      int
+	pollingInterval time.Duration
+	leaseDuration   time.Duration
+
+	log logr.Logger
+}
+
+// Run starts the workers and blocks until the context is canceled.
+func (w *W) Run(ctx context.Context) error {
+	w.log.Info("Starting workers...", "count", w.numWorkers, "owner", w.owner)
+	var wg sync.WaitGroup
+	for i := 0; i < w.numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.runWorker(ctx)
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+func (w *W) runWorker(ctx context.Context) {
+	ticker := time.NewTicker(w.pollingInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			processed, err := w.processNextJob(ctx)
+			if err != nil {
+				w.log.Error(err, "Failed to process a job")
+				break
+			}
+			if !processed {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processNextJob claims a queued job, or a running job whose lease has expired, and processes it. It returns
+// false if there is no job to claim.
+func (w *W) processNextJob(ctx context.Context) (bool, error) {
+	job, err := w.store.ClaimJob(w.owner, time.Now(), w.leaseDuration)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			// Another worker claimed the job. Try the next one.
+			return true, nil
+		}
+		return false, fmt.Errorf("claim job: %s", err)
+	}
+
+	log := w.log.WithValues("file", job.FileID, "store", job.VectorStoreID, "attempt", job.Attempts)
+	log.Info("Processing job")
+
+	f, err := w.store.GetFileByFileID(job.VectorStoreID, job.FileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// The file has been deleted.
+			return true, w.deleteJob(job)
+		}
+		return false, w.releaseJob(job, fmt.Errorf("get file: %s", err))
+	}
+	c, err := w.store.GetCollectionByVectorStoreID(job.ProjectID, job.VectorStoreID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// The vector store has been deleted.
+			return true, w.deleteJob(job)
+		}
+		return false, w.releaseJob(job, fmt.Errorf("get collection: %s", err))
+	}
+
+	// Renew the lease while the job is processed. The job context is canceled if the lease is lost or the
+	// job is deleted together with the file.
+	jobCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	renewCtx, stopRenewal := context.WithCancel(jobCtx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		w.renewLease(renewCtx, job, cancel)
+	}()
+	defer func() {
+		stopRenewal()
+		wg.Wait()
+	}()
+
+	if job.Attempts > 1 {
+		// A previous attempt might have inserted some of the documents. Delete them so that they are not duplicated.
+		if err := w.embedder.DeleteFile(jobCtx, job.VectorStoreID, job.FileID); err != nil {
+			return false, w.releaseJob(job, fmt.Errorf("delete documents of previous attempt: %s", err))
+		}
+	}
+
+	var (
+		chunking *embedder.Chunking
+		addErr   error
+	)
+	var attributes map[string]any
+	if len(f.Attributes) > 0 {
+		if err := json.Unmarshal(f.Attributes, &attributes); err != nil {
+			addErr = fmt.Errorf("unmarshal attributes: %s", err)
+		}
+	}
+	if job.Attempts > maxJobAttempts {
+		addErr = fmt.Errorf("gave up after %d attempts", maxJobAttempts)
+	}
+	if addErr == nil {
+		chunking, addErr = w.addFile(jobCtx, c, f, job, attributes)
+	}
+	if jobCtx.Err() != nil {
+		switch cause := context.Cause(jobCtx); {
+		case errors.Is(cause, errJobDeleted):
+			// The file was deleted while it was being processed. Clean up the inserted documents.
+			log.Info("File was deleted during processing")
+			w.deleteDocuments(ctx, job)
+			return true, nil
+		case errors.Is(cause, errLeaseLost):
+			// Another worker has claimed the job.
+			log.Info("Lost the lease of the job")
+			return true, nil
+		default:
+			// The server is shutting down. Put the job back in the queue so that another worker takes it over.
+			return false, w.releaseJob(job, nil)
+		}
+	}
+	if addErr != nil {
+		log.Error(addErr, "Failed to add file to vector store")
+	}
+
+	found, err := w.completeJob(job, chunking, addErr)
+	if err != nil {
+		if errors.Is(err, store.ErrJobNotOwned) {
+			log.Info("Lost the lease of the job")
+			return true, nil
+		}
+		return false, w.releaseJob(job, err)
+	}
+	if !found {
+		// The file was deleted while it was being processed. Clean up the inserted documents.
+		log.Info("File was deleted during processing")
+		w.deleteDocuments(ctx, job)
+		return true, nil
+	}
+	log.Info("Processed job", "success", addErr == nil)
+	return true, nil
+}
+
+// addFile adds the file to the vector store. A panic while the file is loaded or embedded fails the file
+// instead of the worker.
+func (w *W) addFile(
+	ctx context.Context,
+	c *store.Collection,
+	f *store.File,
+	job *store.Job,
+	attributes map[string]any,
+) (chunking *embedder.Chunking, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			chunking = nil
+			err = fmt.Errorf("panic while adding the file: %v", r)
+		}
+	}()
+	return w.embedder.AddFile(
+		ctx,
+		c.VectorStoreID,
+		c.EmbeddingModel,
+		vectordb.MetricType(c.MetricType),
+		!c.BatchEmbeddings,
+		f.FileID,
+		job.FileName,
+		job.FilePath,
+		embedder.ChunkingStrategy{
+			Type:                     embedder.ChunkingStrategyType(f.ChunkingStrategyType),
+			MaxChunkSizeTokens:       f.MaxChunkSizeTokens,
+			ChunkOverlapTokens:       f.ChunkOverlapTokens,
+			BreakpointPercentile:     f.BreakpointPercentile,
+			MaxParentChunkSizeTokens: f.MaxParentChunkSizeTokens,
+		},
+		attributes,
+	)
+}
+
+// renewLease renews the lease of the job until the context is done. It cancels the job with the cause if
+// the lease cannot be renewed.
+func (w *W) renewLease(ctx context.Context, job *store.Job, cancel context.CancelCauseFunc) {
+	ticker := time.NewTicker(w.leaseDuration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := w.store.RenewJobLease(job.ID, w.owner, time.Now().Add(w.leaseDuration))
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			cancel(errJobDeleted)
+			return
+		case errors.Is(err, store.ErrJobNotOwned):
+			cancel(errLeaseLost)
+			return
+		default:
+			// Retry at the next tick. The lease expires if the renewal keeps failing.
+			w.log.Error(err, "Failed to renew the job lease", "file", job.FileID)
+		}
+	}
+}
+
+// completeJob updates the file status, the chunking used for the file, and the file counts of the collection,
+// and deletes the job. It returns false if the file no longer exists.
+func (w *W) completeJob(job *store.Job, chunking *embedder.Chunking, addErr error) (bool, error) {
+	var found bool
+	var err error
+	for i := 0; i < maxUpdateRetries; i++ {
+		found, err = w.completeJobOnce(job, chunking, addErr)
+		if err == nil || !errors.Is(err, store.ErrConcurrentUpdate) {
+			return found, err
+		}
+	}
+	return false, err
+}
+
+func (w *W) completeJobOnce(job *store.Job, chunking *embedder.Chunking, addErr error) (bool, error) {
+	f, err := w.store.GetFileByFileID(job.VectorStoreID, job.FileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get file: %s", err)
+	}
+	c, err := w.store.GetCollectionByVectorStoreID(job.ProjectID, job.VectorStoreID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get collection: %s", err)
+	}
+
+	if chunking != nil {
+		f.Splitter = chunking.Splitter
+		f.MaxChunkSizeTokens = chunking.MaxChunkSizeTokens
+		f.ChunkOverlapTokens = chunking.ChunkOverlapTokens
+	}
+
+	c.FileCountsInProgress--
+	var partialErr *embedder.PartialArchiveError
+	switch {
+	case addErr == nil:
+		f.Status = store.FileStatusCompleted
+		c.FileCountsCompleted++
+	case errors.As(addErr, &partialErr):
+		// Some members of the archive could not be loaded, but the others have been added.
+		f.Status = store.FileStatusCompleted
+		f.LastErrorCode = store.LastErrorCodeInvalidFile
+		f.LastErrorMessage = partialErr.Error()
+		c.FileCountsCompleted++
+	default:
+		f.Status = store.FileStatusFailed
+		switch {
+		case errors.Is(addErr, embedder.ErrRateLimitExceeded):
+			f.LastErrorCode = store.LastErrorCodeRateLimitExceeded
+		case errors.Is(addErr, embedder.ErrUnsupportedFileType):
+			f.LastErrorCode = store.LastErrorCodeUnsupportedFile
+		default:
+			f.LastErrorCode = store.LastErrorCodeServerError
+		}
+		f.LastErrorMessage = addErr.Error()
+		c.FileCountsFailed++
+	}
+
+	found := true
+	if err := w.store.Transaction(func(tx *gorm.DB) error {
+		if err := store.DeleteOwnedJobInTransaction(tx, job.ID, w.owner); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// The job was deleted together with the file.
+				found = false
+				return nil
+			}
+			return fmt.Errorf("delete job: %w", err)
+		}
+		if err := store.UpdateFileInTransaction(tx, f); err != nil {
+			return fmt.Errorf("update file: %w", err)
+		}
+		if err := store.UpdateCollectionInTransaction(tx, c); err != nil {
+			return fmt.Errorf("update collection: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	return found, nil
+}
+
+func (w *W) deleteJob(job *store.Job) error {
+	if err := w.store.DeleteJob(job.ID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("delete job: %s", err)
+	}
+	return nil
+}
+
+// releaseJob puts the job back in the queue so that it is retried, and returns err. The job is claimed again
+// after its lease expires if it cannot be released.
+func (w *W) releaseJob(job *store.Job, err error) error {
+	if rerr := w.store.ReleaseJob(job.ID, w.owner); rerr != nil &&
+		!errors.Is(rerr, gorm.ErrRecordNotFound) && !errors.Is(rerr, store.ErrJobNotOwned) {
+		w.log.Error(rerr, "Failed to release job", "file", job.FileID)
+	}
+	return err
+}
+
+// deleteDocuments deletes the documents of a file that was deleted while it was being processed.
+func (w *W) deleteDocuments(ctx context.Context, job *store.Job) {
+	if err := w.embedder.DeleteFile(ctx, job.VectorStoreID, job.FileID); err != nil {
+		w.log.Error(err, "Failed to delete documents of deleted file", "file", job.FileID)
+	}
+}
@@ -0,0 +1,442 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/vector-store-manager/server/internal/embedder"
+	"github.com/llmariner/vector-store-manager/server/internal/store"
+	"github.com/llmariner/vector-store-manager/server/internal/vectordb"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestProcessNextJob(t *testing.T) {
+	const (
+		projectID     = "default"
+		vectorStoreID = "vs0"
+		fileID        = "file0"
+	)
+
+	tcs := []struct {
+		name       string
+		addErr     error
+		panicMsg   string
+		wantStatus store.FileStatus
+		wantCode   store.LastErrorCode
+	}{
+		{
+			name:       "success",
+			wantStatus: store.FileStatusCompleted,
+			wantCode:   store.LastErrorCodeNone,
+		},
+		{
+			name:       "failure",
+			addErr:     fmt.Errorf("embed failed"),
+			wantStatus: store.FileStatusFailed,
+			wantCode:   store.LastErrorCodeServerError,
+		},
+		{
+			name:       "rate limit exceeded",
+			addErr:     fmt.Errorf("llm embed: %w", embedder.ErrRateLimitExceeded),
+			wantStatus: store.FileStatusFailed,
+			wantCode:   store.LastErrorCodeRateLimitExceeded,
+		},
+		{
+			name:       "unsupported file",
+			addErr:     fmt.Errorf("detect file type: %w", embedder.ErrUnsupportedFileType),
+			wantStatus: store.FileStatusFailed,
+			wantCode:   store.LastErrorCodeUnsupportedFile,
+		},
+		{
+			name: "partial archive failure",
+			addErr: &embedder.PartialArchiveError{
+				MemberErrors: []embedder.MemberError{{Path: "docs/a.pdf", Err: fmt.Errorf("invalid pdf")}},
+			},
+			wantStatus: store.FileStatusCompleted,
+			wantCode:   store.LastErrorCodeInvalidFile,
+		},
+		{
+			name:       "panic",
+			panicMsg:   "index out of range",
+			wantStatus: store.FileStatusFailed,
+			wantCode:   store.LastErrorCodeServerError,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			err := st.CreateCollection(&store.Collection{
+				VectorStoreID:        vectorStoreID,
+				ProjectID:            projectID,
+				EmbeddingModel:       "model0",
+				MetricType:           string(vectordb.MetricTypeCosine),
+				FileCountsInProgress: 1,
+				FileCountsTotal:      1,
+			})
+			assert.NoError(t, err)
+			err = st.CreateFile(&store.File{
+				VectorStoreID:        vectorStoreID,
+				FileID:               fileID,
+				Status:               store.FileStatusInProgress,
+				ChunkingStrategyType: store.ChunkingStrategyTypeAuto,
+				Attributes:           []byte(`{"author":"alice","year":2024}`),
+			})
+			assert.NoError(t, err)
+			err = st.CreateJob(&store.Job{
+				ProjectID:     projectID,
+				VectorStoreID: vectorStoreID,
+				FileID:        fileID,
+				FileName:      "file0.txt",
+				FilePath:      "path/file0",
+				Status:        store.JobStatusQueued,
+			})
+			assert.NoError(t, err)
+
+			e := &fakeEmbedder{err: tc.addErr, panicMsg: tc.panicMsg}
+			w, err := New(st, e, 1, time.Second, testr.New(t))
+			assert.NoError(t, err)
+
+			processed, err := w.processNextJob(context.Background())
+			assert.NoError(t, err)
+			assert.True(t, processed)
+			assert.Equal(t, []string{fileID}, e.added)
+			// The documents are not deleted on the first attempt.
+			assert.Empty(t, e.deleted)
+			assert.Equal(t, "path/file0", e.filePath)
+			assert.Equal(t, vectordb.MetricTypeCosine, e.metric)
+			assert.Equal(t, map[string]any{"author": "alice", "year": 2024.0}, e.attributes)
+
+			f, err := st.GetFileByFileID(vectorStoreID, fileID)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, f.Status)
+			assert.Equal(t, tc.wantCode, f.LastErrorCode)
+			if tc.wantStatus == store.FileStatusCompleted {
+				// The chunking resolved by the embedder is recorded.
+				assert.Equal(t, "recursive", f.Splitter)
+				assert.Equal(t, int64(800), f.MaxChunkSizeTokens)
+				assert.Equal(t, int64(400), f.ChunkOverlapTokens)
+			} else {
+				assert.Empty(t, f.Splitter)
+			}
+
+			c, err := st.GetCollectionByVectorStoreID(projectID, vectorStoreID)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(0), c.FileCountsInProgress)
+			if tc.wantStatus == store.FileStatusCompleted {
+				assert.Equal(t, int64(1), c.FileCountsCompleted)
+			} else {
+				assert.Equal(t, int64(1), c.FileCountsFailed)
+			}
+
+			_, err = st.GetJobByFileID(vectorStoreID, fileID)
+			assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+			processed, err = w.processNextJob(context.Background())
+			assert.NoError(t, err)
+			assert.False(t, processed)
+		})
+	}
+}
+
+func TestProcessNextJob_FileDeleted(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateJob(&store.Job{
+		ProjectID:     "default",
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.JobStatusQueued,
+	})
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{}
+	w, err := New(st, e, 1, time.Second, testr.New(t))
+	assert.NoError(t, err)
+	processed, err := w.processNextJob(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Empty(t, e.added)
+
+	_, err = st.GetJobByFileID("vs0", "file0")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestProcessNextJob_ExpiredLease(t *testing.T) {
+	const (
+		projectID     = "default"
+		vectorStoreID = "vs0"
+	)
+
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateCollection(&store.Collection{
+		VectorStoreID:        vectorStoreID,
+		ProjectID:            projectID,
+		FileCountsInProgress: 2,
+		FileCountsTotal:      2,
+	})
+	assert.NoError(t, err)
+	for _, fileID := range []string{"file0", "file1"} {
+		err = st.CreateFile(&store.File{
+			VectorStoreID: vectorStoreID,
+			FileID:        fileID,
+			Status:        store.FileStatusInProgress,
+		})
+		assert.NoError(t, err)
+	}
+
+	now := time.Now()
+	// file0 is run by a worker that has stopped, and file1 is run by a live worker.
+	for _, j := range []*store.Job{
+		{FileID: "file0", LeaseExpiresAt: now.Add(-time.Second).Unix()},
+		{FileID: "file1", LeaseExpiresAt: now.Add(time.Hour).Unix()},
+	} {
+		j.ProjectID = projectID
+		j.VectorStoreID = vectorStoreID
+		j.Status = store.JobStatusRunning
+		j.Owner = "other"
+		j.Attempts = 1
+		err = st.CreateJob(j)
+		assert.NoError(t, err)
+	}
+
+	e := &fakeEmbedder{}
+	w, err := New(st, e, 1, time.Second, testr.New(t))
+	assert.NoError(t, err)
+
+	processed, err := w.processNextJob(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	// The documents inserted by the previous attempt are deleted before the file is added again.
+	assert.Equal(t, []string{"file0"}, e.deleted)
+	assert.Equal(t, []string{"file0"}, e.added)
+
+	f, err := st.GetFileByFileID(vectorStoreID, "file0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusCompleted, f.Status)
+
+	processed, err = w.processNextJob(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, processed)
+	job, err := st.GetJobByFileID(vectorStoreID, "file1")
+	assert.NoError(t, err)
+	assert.Equal(t, "other", job.Owner)
+}
+
+func TestProcessNextJob_MaxAttempts(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateCollection(&store.Collection{
+		VectorStoreID:        "vs0",
+		ProjectID:            "default",
+		FileCountsInProgress: 1,
+		FileCountsTotal:      1,
+	})
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.FileStatusInProgress,
+	})
+	assert.NoError(t, err)
+	err = st.CreateJob(&store.Job{
+		ProjectID:     "default",
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.JobStatusQueued,
+		Attempts:      maxJobAttempts,
+	})
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{}
+	w, err := New(st, e, 1, time.Second, testr.New(t))
+	assert.NoError(t, err)
+	processed, err := w.processNextJob(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Empty(t, e.added)
+
+	f, err := st.GetFileByFileID("vs0", "file0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusFailed, f.Status)
+	assert.Equal(t, store.LastErrorCodeServerError, f.LastErrorCode)
+}
+
+func TestProcessNextJob_InvalidAttributes(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateCollection(&store.Collection{
+		VectorStoreID:        "vs0",
+		ProjectID:            "default",
+		FileCountsInProgress: 1,
+		FileCountsTotal:      1,
+	})
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.FileStatusInProgress,
+		Attributes:    []byte(`{`),
+	})
+	assert.NoError(t, err)
+	err = st.CreateJob(&store.Job{
+		ProjectID:     "default",
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.JobStatusQueued,
+	})
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{}
+	w, err := New(st, e, 1, time.Second, testr.New(t))
+	assert.NoError(t, err)
+	processed, err := w.processNextJob(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Empty(t, e.added)
+
+	f, err := st.GetFileByFileID("vs0", "file0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.FileStatusFailed, f.Status)
+	_, err = st.GetJobByFileID("vs0", "file0")
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
+
+func TestProcessNextJob_Shutdown(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateCollection(&store.Collection{
+		VectorStoreID: "vs0",
+		ProjectID:     "default",
+	})
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.FileStatusInProgress,
+	})
+	assert.NoError(t, err)
+	err = st.CreateJob(&store.Job{
+		ProjectID:     "default",
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.JobStatusQueued,
+	})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &fakeEmbedder{onAdd: func(context.Context) { cancel() }}
+	w, err := New(st, e, 1, time.Second, testr.New(t))
+	assert.NoError(t, err)
+	processed, err := w.processNextJob(ctx)
+	assert.NoError(t, err)
+	assert.False(t, processed)
+
+	// The job is put back in the queue.
+	job, err := st.GetJobByFileID("vs0", "file0")
+	assert.NoError(t, err)
+	assert.Equal(t, store.JobStatusQueued, job.Status)
+	assert.Empty(t, job.Owner)
+}
+
+func TestProcessNextJob_JobDeleted(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateCollection(&store.Collection{
+		VectorStoreID: "vs0",
+		ProjectID:     "default",
+	})
+	assert.NoError(t, err)
+	err = st.CreateFile(&store.File{
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.FileStatusInProgress,
+	})
+	assert.NoError(t, err)
+	err = st.CreateJob(&store.Job{
+		ProjectID:     "default",
+		VectorStoreID: "vs0",
+		FileID:        "file0",
+		Status:        store.JobStatusQueued,
+	})
+	assert.NoError(t, err)
+
+	e := &fakeEmbedder{
+		onAdd: func(ctx context.Context) {
+			// The file is deleted while it is being added. The renewal of the lease cancels the addition.
+			err := st.Transaction(func(tx *gorm.DB) error {
+				return store.DeleteJobsByFileIDInTransaction(tx, "vs0", "file0")
+			})
+			assert.NoError(t, err)
+			<-ctx.Done()
+		},
+	}
+	w, err := New(st, e, 1, time.Second, testr.New(t))
+	assert.NoError(t, err)
+	w.leaseDuration = 30 * time.Millisecond
+	processed, err := w.processNextJob(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Equal(t, []string{"file0"}, e.deleted)
+}
+
+type fakeEmbedder struct {
+	err      error
+	panicMsg string
+	onAdd    func(ctx context.Context)
+
+	added      []string
+	deleted    []string
+	filePath   string
+	metric     vectordb.MetricType
+	attributes map[string]any
+}
+
+func (e *fakeEmbedder) AddFile(
+	ctx context.Context,
+	collectionName, modelName string,
+	metric vectordb.MetricType,
+	legacyEmbedding bool,
+	fileID, fileName, filePath string,
+	cs embedder.ChunkingStrategy,
+	attributes map[string]any,
+) (*embedder.Chunking, error) {
+	e.added = append(e.added, fileID)
+	e.filePath = filePath
+	e.metric = metric
+	e.attributes = attributes
+	if e.onAdd != nil {
+		e.onAdd(ctx)
+	}
+	if e.panicMsg != "" {
+		panic(e.panicMsg)
+	}
+	var partialErr *embedder.PartialArchiveError
+	if e.err != nil && !errors.As(e.err, &partialErr) {
+		return nil, e.err
+	}
+	return &embedder.Chunking{
+		Splitter:           "recursive",
+		MaxChunkSizeTokens: 800,
+		ChunkOverlapTokens: 400,
+	}, e.err
+}
+
+func (e *fakeEmbedder) DeleteFile(ctx context.Context, collectionName, fileID string) error {
+	e.deleted = append(e.deleted, fileID)
+	return nil
+}
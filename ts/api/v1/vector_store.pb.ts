@@ -5,11 +5,23 @@
 */
 
 import * as fm from "../../fetch.pb"
+import * as GoogleProtobufStruct from "../../google/protobuf/struct.pb"
 export type ExpiresAfter = {
   anchor?: string
   days?: string
 }
 
+export type VectorIndex = {
+  type?: string
+  nlist?: number
+  nprobe?: number
+  m?: number
+  nbits?: number
+  ef_construction?: number
+  ef?: number
+  search_list?: number
+}
+
 export type VectorStoreFileCounts = {
   in_progress?: string
   completed?: string
@@ -30,6 +42,9 @@ export type VectorStore = {
   expires_at?: string
   last_active_at?: string
   metadata?: {[key: string]: string}
+  rerank?: boolean
+  metric_type?: string
+  index?: VectorIndex
 }
 
 export type ChunkingStrategyStatic = {
@@ -37,9 +52,22 @@ export type ChunkingStrategyStatic = {
   chunk_overlap_tokens?: string
 }
 
+export type ChunkingStrategySemantic = {
+  breakpoint_percentile?: number
+  max_chunk_size_tokens?: string
+}
+
+export type ChunkingStrategyParentChild = {
+  max_parent_chunk_size_tokens?: string
+  max_child_chunk_size_tokens?: string
+}
+
 export type ChunkingStrategy = {
   type?: string
   static?: ChunkingStrategyStatic
+  splitter?: string
+  semantic?: ChunkingStrategySemantic
+  parent_child?: ChunkingStrategyParentChild
 }
 
 export type CreateVectorStoreRequest = {
@@ -48,6 +76,9 @@ export type CreateVectorStoreRequest = {
   expires_after?: ExpiresAfter
   chunking_strategy?: ChunkingStrategy
   metadata?: {[key: string]: string}
+  rerank?: boolean
+  metric_type?: string
+  index?: VectorIndex
 }
 
 export type ListVectorStoresRequest = {
@@ -104,12 +135,14 @@ export type VectorStoreFile = {
   status?: string
   last_error?: VectorStoreFileError
   chunking_strategy?: ChunkingStrategy
+  attributes?: {[key: string]: GoogleProtobufStruct.Value}
 }
 
 export type CreateVectorStoreFileRequest = {
   vector_store_id?: string
   file_id?: string
   chunking_strategy?: ChunkingStrategy
+  attributes?: {[key: string]: GoogleProtobufStruct.Value}
 }
 
 export type ListVectorStoreFilesRequest = {
@@ -134,6 +167,12 @@ export type GetVectorStoreFileRequest = {
   file_id?: string
 }
 
+export type UpdateVectorStoreFileRequest = {
+  vector_store_id?: string
+  file_id?: string
+  attributes?: {[key: string]: GoogleProtobufStruct.Value}
+}
+
 export type DeleteVectorStoreFileRequest = {
   vector_store_id?: string
   file_id?: string
@@ -145,14 +184,85 @@ export type DeleteVectorStoreFileResponse = {
   deleted?: boolean
 }
 
+export type VectorStoreSearchFilter = {
+  type?: string
+  key?: string
+  value?: GoogleProtobufStruct.Value
+  filters?: VectorStoreSearchFilter[]
+}
+
+export type VectorStoreSearchRankingOptions = {
+  ranker?: string
+  score_threshold?: number
+}
+
+export type VectorStoreSearchRequest = {
+  vector_store_id?: string
+  query?: string
+  max_num_results?: number
+  filters?: VectorStoreSearchFilter
+  ranking_options?: VectorStoreSearchRankingOptions
+}
+
+export type VectorStoreSearchResultContent = {
+  type?: string
+  text?: string
+}
+
+export type VectorStoreSearchResult = {
+  file_id?: string
+  filename?: string
+  score?: number
+  attributes?: {[key: string]: GoogleProtobufStruct.Value}
+  content?: VectorStoreSearchResultContent[]
+}
+
+export type VectorStoreSearchResponse = {
+  object?: string
+  search_query?: string[]
+  data?: VectorStoreSearchResult[]
+  has_more?: boolean
+  next_page?: string
+}
+
 export type SearchVectorStoreRequest = {
   vector_store_id?: string
   query?: string
   num_documents?: number
+  context_window?: number
+  search_mode?: string
+  fusion?: string
+  vector_weight?: number
+  rerank?: boolean
+  mmr?: boolean
+  mmr_lambda?: number
+  max_results_per_file?: number
+  score_threshold?: number
+}
+
+export type SearchVectorStoreResponseResult = {
+  chunk_id?: string
+  file_id?: string
+  filename?: string
+  distance?: number
+  chunk_index?: number
+  page_number?: number
+  text?: string
+  score?: number
 }
 
 export type SearchVectorStoreResponse = {
   documents?: string[]
+  results?: SearchVectorStoreResponseResult[]
+}
+
+export type RebuildVectorStoreIndexRequest = {
+  vector_store_id?: string
+  index?: VectorIndex
+}
+
+export type RebuildVectorStoreIndexResponse = {
+  index?: VectorIndex
 }
 
 export class VectorStoreService {
@@ -183,12 +293,21 @@ export class VectorStoreService {
   static GetVectorStoreFile(req: GetVectorStoreFileRequest, initReq?: fm.InitReq): Promise<VectorStoreFile> {
     return fm.fetchReq<GetVectorStoreFileRequest, VectorStoreFile>(`/v1/vector_stores/${req["vector_store_id"]}/files/${req["file_id"]}?${fm.renderURLSearchParams(req, ["vector_store_id", "file_id"])}`, {...initReq, method: "GET"})
   }
+  static UpdateVectorStoreFile(req: UpdateVectorStoreFileRequest, initReq?: fm.InitReq): Promise<VectorStoreFile> {
+    return fm.fetchReq<UpdateVectorStoreFileRequest, VectorStoreFile>(`/v1/vector_stores/${req["vector_store_id"]}/files/${req["file_id"]}`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static DeleteVectorStoreFile(req: DeleteVectorStoreFileRequest, initReq?: fm.InitReq): Promise<DeleteVectorStoreFileResponse> {
     return fm.fetchReq<DeleteVectorStoreFileRequest, DeleteVectorStoreFileResponse>(`/v1/vector_stores/${req["vector_store_id"]}/files/${req["file_id"]}`, {...initReq, method: "DELETE"})
   }
+  static SearchVectorStore(req: VectorStoreSearchRequest, initReq?: fm.InitReq): Promise<VectorStoreSearchResponse> {
+    return fm.fetchReq<VectorStoreSearchRequest, VectorStoreSearchResponse>(`/v1/vector_stores/${req["vector_store_id"]}/search`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
 }
 export class VectorStoreInternalService {
   static SearchVectorStore(req: SearchVectorStoreRequest, initReq?: fm.InitReq): Promise<SearchVectorStoreResponse> {
     return fm.fetchReq<SearchVectorStoreRequest, SearchVectorStoreResponse>(`/llmariner.vector_store.v1.VectorStoreInternalService/SearchVectorStore`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static RebuildVectorStoreIndex(req: RebuildVectorStoreIndexRequest, initReq?: fm.InitReq): Promise<RebuildVectorStoreIndexResponse> {
+    return fm.fetchReq<RebuildVectorStoreIndexRequest, RebuildVectorStoreIndexResponse>(`/llmariner.vector_store.v1.VectorStoreInternalService/RebuildVectorStoreIndex`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
 }
\ No newline at end of file